@@ -0,0 +1,112 @@
+package v1alpha1
+
+import (
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+)
+
+// The conversions below translate the settings shared by FalconContainer and FalconNodeSensor between v1alpha1 and v1beta1,
+// the storage version. Both versions hold the same settings under different names, so no setting is lost in either direction.
+
+func convertFalconAPIToV1beta1(src *FalconAPI) *v1beta1.FalconAPI {
+	if src == nil {
+		return nil
+	}
+
+	dst := &v1beta1.FalconAPI{
+		CloudRegion:  src.CloudRegion,
+		ClientID:     src.ClientId,
+		ClientSecret: src.ClientSecret,
+		CID:          src.CID,
+	}
+	if src.SecretRef != nil {
+		dst.SecretRef = &v1beta1.SecretReference{Name: src.SecretRef.Name, Namespace: src.SecretRef.Namespace}
+	}
+	return dst
+}
+
+func convertFalconAPIFromV1beta1(src *v1beta1.FalconAPI) *FalconAPI {
+	if src == nil {
+		return nil
+	}
+
+	dst := &FalconAPI{
+		CloudRegion:  src.CloudRegion,
+		ClientId:     src.ClientID,
+		ClientSecret: src.ClientSecret,
+		CID:          src.CID,
+	}
+	if src.SecretRef != nil {
+		dst.SecretRef = &FalconAPISecretRef{Name: src.SecretRef.Name, Namespace: src.SecretRef.Namespace}
+	}
+	return dst
+}
+
+func convertFalconSensorToV1beta1(src FalconSensor) v1beta1.FalconSensor {
+	dst := v1beta1.FalconSensor{
+		CID:               src.CID,
+		APD:               src.APD,
+		APH:               src.APH,
+		APP:               src.APP,
+		Billing:           src.Billing,
+		ProvisioningToken: src.PToken,
+		Tags:              src.Tags,
+		Trace:             src.Trace,
+		MessageLog:        src.MessageLog,
+		Update:            src.Update,
+	}
+	if src.Feature != nil {
+		dst.Features = make([]v1beta1.FalconSensorFeature, 0, len(src.Feature))
+		for _, feature := range src.Feature {
+			dst.Features = append(dst.Features, v1beta1.FalconSensorFeature(feature))
+		}
+	}
+	return dst
+}
+
+func convertFalconSensorFromV1beta1(src v1beta1.FalconSensor) FalconSensor {
+	dst := FalconSensor{
+		CID:        src.CID,
+		APD:        src.APD,
+		APH:        src.APH,
+		APP:        src.APP,
+		Billing:    src.Billing,
+		PToken:     src.ProvisioningToken,
+		Tags:       src.Tags,
+		Trace:      src.Trace,
+		MessageLog: src.MessageLog,
+		Update:     src.Update,
+	}
+	if src.Features != nil {
+		dst.Feature = make([]FalconSensorFeature, 0, len(src.Features))
+		for _, feature := range src.Features {
+			dst.Feature = append(dst.Feature, FalconSensorFeature(feature))
+		}
+	}
+	return dst
+}
+
+func convertRegistryToV1beta1(src RegistrySpec) v1beta1.RegistrySpec {
+	dst := v1beta1.RegistrySpec{
+		Type:    v1beta1.RegistryTypeSpec(src.Type),
+		TLS:     v1beta1.RegistryTLSSpec(src.TLS),
+		ACRName: src.AcrName,
+		URI:     src.Uri,
+	}
+	if src.PushSecretRef != nil {
+		dst.PushSecretRef = &v1beta1.SecretReference{Name: src.PushSecretRef.Name, Namespace: src.PushSecretRef.Namespace}
+	}
+	return dst
+}
+
+func convertRegistryFromV1beta1(src v1beta1.RegistrySpec) RegistrySpec {
+	dst := RegistrySpec{
+		Type:    RegistryTypeSpec(src.Type),
+		TLS:     RegistryTLSSpec(src.TLS),
+		AcrName: src.ACRName,
+		Uri:     src.URI,
+	}
+	if src.PushSecretRef != nil {
+		dst.PushSecretRef = &RegistryPushSecretRef{Name: src.PushSecretRef.Name, Namespace: src.PushSecretRef.Namespace}
+	}
+	return dst
+}
@@ -0,0 +1,79 @@
+package v1alpha1
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+const fuzzIterations = 500
+
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(rand.Int63()), serializer.NewCodecFactory(scheme))
+
+	tests := []struct {
+		name  string
+		spoke func() conversion.Convertible
+		hub   func() conversion.Hub
+	}{
+		{"FalconContainer", func() conversion.Convertible { return &FalconContainer{} }, func() conversion.Hub { return &v1beta1.FalconContainer{} }},
+		{"FalconNodeSensor", func() conversion.Convertible { return &FalconNodeSensor{} }, func() conversion.Hub { return &v1beta1.FalconNodeSensor{} }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" v1alpha1", func(t *testing.T) {
+			for i := 0; i < fuzzIterations; i++ {
+				want := tt.spoke()
+				f.Fuzz(want)
+
+				hub := tt.hub()
+				if err := want.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				got := tt.spoke()
+				if err := got.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+
+				if !equality.Semantic.DeepEqual(want, got) {
+					t.Fatalf("v1alpha1 round trip through v1beta1 mismatch: %s", diff.ObjectReflectDiff(want, got))
+				}
+			}
+		})
+
+		t.Run(tt.name+" v1beta1", func(t *testing.T) {
+			for i := 0; i < fuzzIterations; i++ {
+				want := tt.hub()
+				f.Fuzz(want)
+
+				spoke := tt.spoke()
+				if err := spoke.ConvertFrom(want); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				got := tt.hub()
+				if err := spoke.ConvertTo(got); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+
+				if !equality.Semantic.DeepEqual(want, got) {
+					t.Fatalf("v1beta1 round trip through v1alpha1 mismatch: %s", diff.ObjectReflectDiff(want, got))
+				}
+			}
+		})
+	}
+}
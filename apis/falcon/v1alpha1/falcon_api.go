@@ -1,14 +1,5 @@
 package v1alpha1
 
-import (
-	"context"
-	"fmt"
-
-	"github.com/crowdstrike/falcon-operator/pkg/falcon_api"
-	"github.com/crowdstrike/falcon-operator/version"
-	"github.com/crowdstrike/gofalcon/falcon"
-)
-
 // FalconAPI configures connection from your local Falcon operator to CrowdStrike Falcon platform.
 type FalconAPI struct {
 	// Cloud Region defines CrowdStrike Falcon Cloud Region to which the operator will connect and register.
@@ -17,16 +8,28 @@ type FalconAPI struct {
 	CloudRegion string `json:"cloud_region"`
 	// Falcon OAuth2 API Client ID
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client ID",order=1
-	ClientId string `json:"client_id"`
+	ClientId string `json:"client_id,omitempty"`
 	// Falcon OAuth2 API Client Secret
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Secret",order=2
-	ClientSecret string `json:"client_secret"`
+	ClientSecret string `json:"client_secret,omitempty"`
+	// Reference to a Secret containing the Falcon OAuth2 API credentials under the falcon-client-id and falcon-client-secret keys.
+	// When set, it takes precedence over client_id and client_secret.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Credentials Secret",order=5
+	SecretRef *FalconAPISecretRef `json:"secretRef,omitempty"`
 	// Falcon Customer ID (CID) Override (optional, default is derived from the API Key pair)
 	// +kubebuilder:validation:Pattern="^[0-9a-fA-F]{32}-[0-9a-fA-F]{2}$"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Customer ID (CID)",order=4
 	CID *string `json:"cid,omitempty"`
 }
 
+// FalconAPISecretRef references a Secret holding the Falcon OAuth2 API credentials.
+type FalconAPISecretRef struct {
+	// Name of the Secret
+	Name string `json:"name"`
+	// Namespace of the Secret
+	Namespace string `json:"namespace"`
+}
+
 // CrowdStrike Falcon Sensor configuration settings.
 // +k8s:openapi-gen=true
 type FalconSensor struct {
@@ -62,8 +65,29 @@ type FalconSensor struct {
 	// +kubebuilder:default:=none
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trace Level",order=7
 	Trace string `json:"trace,omitempty"`
+	// Sensor features to turn on or off. none clears the features set before.
+	// +kubebuilder:validation:MaxItems:=4
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sensor Features",order=9
+	Feature []FalconSensorFeature `json:"feature,omitempty"`
+	// Log sensor messages to the system log of the host.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Message Log",order=10
+	MessageLog *bool `json:"message_log,omitempty"`
+	// Overwrite the options of a sensor that was configured before, rather than keeping the values set at its first start.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Update Sensor Options",order=11
+	Update *bool `json:"update,omitempty"`
 }
 
+// FalconSensorFeature is a sensor feature set with falconctl --feature
+// +kubebuilder:validation:Enum:=none;enableLog;disableLogBuffer;disableOsfm
+type FalconSensorFeature string
+
+const (
+	FalconSensorFeatureNone             FalconSensorFeature = "none"
+	FalconSensorFeatureEnableLog        FalconSensorFeature = "enableLog"
+	FalconSensorFeatureDisableLogBuffer FalconSensorFeature = "disableLogBuffer"
+	FalconSensorFeatureDisableOsfm      FalconSensorFeature = "disableOsfm"
+)
+
 // RegistryTLSSpec configures TLS for registry pushing
 type RegistryTLSSpec struct {
 	// Allow pushing to docker registries over HTTPS with failed TLS verification. Note that this does not affect other TLS connections.
@@ -90,30 +114,41 @@ const (
 	RegistryTypeACR RegistryTypeSpec = "acr"
 	// RegistryTypeCrowdStrike represents deployment that won't push Falcon Container to local registry, instead CrowdStrike registry will be used.
 	RegistryTypeCrowdStrike RegistryTypeSpec = "crowdstrike"
+	// RegistryTypeGeneric represents any OCI registry, such as Harbor or Artifactory, given by Uri and PushSecretRef
+	RegistryTypeGeneric RegistryTypeSpec = "generic"
 )
 
 // RegistrySpec configures container image registry to which the Falcon Container image will be pushed
 type RegistrySpec struct {
 	// Type of the registry to be used
-	// +kubebuilder:validation:Enum=acr;ecr;gcr;crowdstrike;openshift
+	// +kubebuilder:validation:Enum=acr;ecr;gcr;crowdstrike;openshift;generic
 	Type RegistryTypeSpec `json:"type"`
 
-	// TLS configures TLS connection for push of Falcon Container image to the registry
+	// TLS configures TLS connection for push of the Falcon image to the registry
 	TLS RegistryTLSSpec `json:"tls,omitempty"`
-	// Azure Container Registry Name represents the name of the ACR for the Falcon Container push. Only applicable to Azure cloud.
+	// Azure Container Registry Name represents the name of the ACR for the Falcon image push. Only applicable to Azure cloud.
 	AcrName *string `json:"acr_name,omitempty"`
+	// Repository the Falcon image is pushed to, for instance harbor.example.com/falcon/falcon-container. Only applicable to the generic registry type.
+	Uri string `json:"uri,omitempty"`
+	// Reference to a Secret of type kubernetes.io/dockerconfigjson holding the credentials for pushing to Uri. Only applicable to the generic registry type.
+	PushSecretRef *RegistryPushSecretRef `json:"pushSecretRef,omitempty"`
 }
 
-// ApiConfig generates standard gofalcon library api config
-func (fa *FalconAPI) ApiConfig() *falcon.ApiConfig {
-	return &falcon.ApiConfig{
-		Cloud:             falcon.Cloud(fa.CloudRegion),
-		ClientId:          fa.ClientId,
-		ClientSecret:      fa.ClientSecret,
-		UserAgentOverride: fmt.Sprintf("falcon-operator/%s", version.Version),
-	}
+// RegistryPushSecretRef references a Secret holding registry push credentials.
+type RegistryPushSecretRef struct {
+	// Name of the Secret
+	Name string `json:"name"`
+	// Namespace of the Secret. Defaults to the install namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 }
 
-func (fa *FalconAPI) FalconCloud(ctx context.Context) (falcon.CloudType, error) {
-	return falcon_api.FalconCloud(ctx, fa.ApiConfig())
+// ImageVerificationSpec configures signature verification of the Falcon sensor image before it is mirrored or rolled out
+type ImageVerificationSpec struct {
+	// PEM encoded cosign public keys. The image must carry a sigstore signature, as created by cosign, made with one of these keys.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cosign Public Keys",order=1
+	CosignPublicKeys []string `json:"cosignPublicKeys,omitempty"`
+	// A containers-policy.json document the image must satisfy. Takes precedence over cosignPublicKeys.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Signature Policy (containers-policy.json)",order=2
+	Policy string `json:"policy,omitempty"`
 }
@@ -0,0 +1,106 @@
+package v1alpha1
+
+import (
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this FalconContainer to the v1beta1 storage version
+func (src *FalconContainer) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.FalconContainer)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = v1beta1.FalconContainerSpec{
+		InstallNamespace:  src.Spec.InstallNamespace,
+		Falcon:            convertFalconSensorToV1beta1(src.Spec.Falcon),
+		FalconAPI:         convertFalconAPIToV1beta1(src.Spec.FalconAPI),
+		Registry:          convertRegistryToV1beta1(src.Spec.Registry),
+		Injector:          convertInjectorToV1beta1(src.Spec.Injector),
+		ImageVerification: (*v1beta1.ImageVerificationSpec)(src.Spec.ImageVerification),
+		Image:             src.Spec.Image,
+		Version:           src.Spec.Version,
+		RefreshInterval:   src.Spec.RefreshInterval,
+	}
+	dst.Status = v1beta1.FalconContainerStatus(src.Status)
+
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 storage version to this FalconContainer
+func (dst *FalconContainer) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.FalconContainer)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = FalconContainerSpec{
+		InstallNamespace:  src.Spec.InstallNamespace,
+		Falcon:            convertFalconSensorFromV1beta1(src.Spec.Falcon),
+		FalconAPI:         convertFalconAPIFromV1beta1(src.Spec.FalconAPI),
+		Registry:          convertRegistryFromV1beta1(src.Spec.Registry),
+		Injector:          convertInjectorFromV1beta1(src.Spec.Injector),
+		ImageVerification: (*ImageVerificationSpec)(src.Spec.ImageVerification),
+		Image:             src.Spec.Image,
+		Version:           src.Spec.Version,
+		RefreshInterval:   src.Spec.RefreshInterval,
+	}
+	dst.Status = FalconContainerStatus(src.Status)
+
+	return nil
+}
+
+func convertInjectorToV1beta1(src FalconContainerInjectorSpec) v1beta1.FalconContainerInjectorSpec {
+	dst := v1beta1.FalconContainerInjectorSpec{
+		ServiceAccount: v1beta1.FalconContainerServiceAccount(src.ServiceAccount),
+		ListenPort:     src.ListenPort,
+		TLS: v1beta1.FalconContainerInjectorTLS{
+			Validity:     src.TLS.Validity,
+			RenewBefore:  src.TLS.RenewBefore,
+			KeyAlgorithm: src.TLS.KeyAlgorithm,
+		},
+		ImagePullPolicy:                src.ImagePullPolicy,
+		ImagePullSecretName:            src.ImagePullSecretName,
+		LogVolume:                      src.LogVolume,
+		Resources:                      src.Resources,
+		SensorResources:                src.SensorResources,
+		AdditionalEnvironmentVariables: src.AdditionalEnvironmentVariables,
+		DisableDefaultNSInjection:      src.DisableDefaultNSInjection,
+		DisableDefaultPodInjection:     src.DisableDefaultPodInjection,
+		AzureConfigPath:                src.AzureConfigPath,
+		Replicas:                       src.Replicas,
+		Webhook:                        v1beta1.FalconContainerWebhook(src.Webhook),
+	}
+	if src.TLS.CertManager != nil {
+		dst.TLS.CertManager = &v1beta1.FalconContainerCertManager{
+			IssuerRef: (*v1beta1.FalconCertManagerIssuerRef)(src.TLS.CertManager.IssuerRef),
+		}
+	}
+	return dst
+}
+
+func convertInjectorFromV1beta1(src v1beta1.FalconContainerInjectorSpec) FalconContainerInjectorSpec {
+	dst := FalconContainerInjectorSpec{
+		ServiceAccount: FalconContainerServiceAccount(src.ServiceAccount),
+		ListenPort:     src.ListenPort,
+		TLS: FalconContainerInjectorTLS{
+			Validity:     src.TLS.Validity,
+			RenewBefore:  src.TLS.RenewBefore,
+			KeyAlgorithm: src.TLS.KeyAlgorithm,
+		},
+		ImagePullPolicy:                src.ImagePullPolicy,
+		ImagePullSecretName:            src.ImagePullSecretName,
+		LogVolume:                      src.LogVolume,
+		Resources:                      src.Resources,
+		SensorResources:                src.SensorResources,
+		AdditionalEnvironmentVariables: src.AdditionalEnvironmentVariables,
+		DisableDefaultNSInjection:      src.DisableDefaultNSInjection,
+		DisableDefaultPodInjection:     src.DisableDefaultPodInjection,
+		AzureConfigPath:                src.AzureConfigPath,
+		Replicas:                       src.Replicas,
+		Webhook:                        FalconContainerWebhook(src.Webhook),
+	}
+	if src.TLS.CertManager != nil {
+		dst.TLS.CertManager = &FalconContainerCertManager{
+			IssuerRef: (*FalconCertManagerIssuerRef)(src.TLS.CertManager.IssuerRef),
+		}
+	}
+	return dst
+}
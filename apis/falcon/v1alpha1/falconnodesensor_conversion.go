@@ -0,0 +1,166 @@
+package v1alpha1
+
+import (
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConvertTo converts this FalconNodeSensor to the v1beta1 storage version
+func (src *FalconNodeSensor) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1beta1.FalconNodeSensor)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = v1beta1.FalconNodeSensorSpec{
+		InstallNamespace:  src.Spec.InstallNamespace,
+		Node:              convertNodeConfigToV1beta1(src.Spec.Node),
+		Falcon:            convertFalconSensorToV1beta1(src.Spec.Falcon),
+		FalconAPI:         convertFalconAPIToV1beta1(src.Spec.FalconAPI),
+		ImageVerification: (*v1beta1.ImageVerificationSpec)(src.Spec.ImageVerification),
+	}
+	if src.Spec.Registry != nil {
+		registry := convertRegistryToV1beta1(*src.Spec.Registry)
+		dst.Spec.Registry = &registry
+	}
+
+	dst.Status = v1beta1.FalconNodeSensorStatus{
+		Sensor:                 src.Status.Sensor,
+		ImageDigest:            src.Status.ImageDigest,
+		PendingSensor:          src.Status.PendingSensor,
+		PendingSince:           src.Status.PendingSince,
+		Version:                src.Status.Version,
+		DesiredNumberScheduled: src.Status.DesiredNumberScheduled,
+		NumberReady:            src.Status.NumberReady,
+		UpdatedNumberScheduled: src.Status.UpdatedNumberScheduled,
+		NumberUnavailable:      src.Status.NumberUnavailable,
+		Conditions:             src.Status.Conditions,
+	}
+	if src.Status.Architectures != nil {
+		dst.Status.Architectures = make([]v1beta1.FalconNodeArchitectureStatus, 0, len(src.Status.Architectures))
+		for _, arch := range src.Status.Architectures {
+			dst.Status.Architectures = append(dst.Status.Architectures, v1beta1.FalconNodeArchitectureStatus{
+				Architecture:           v1beta1.NodeArchitecture(arch.Architecture),
+				DaemonSet:              arch.DaemonSet,
+				Sensor:                 arch.Sensor,
+				ImageDigest:            arch.ImageDigest,
+				Image:                  arch.Image,
+				DesiredNumberScheduled: arch.DesiredNumberScheduled,
+				NumberReady:            arch.NumberReady,
+			})
+		}
+	}
+
+	return nil
+}
+
+// ConvertFrom converts the v1beta1 storage version to this FalconNodeSensor
+func (dst *FalconNodeSensor) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1beta1.FalconNodeSensor)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = FalconNodeSensorSpec{
+		InstallNamespace:  src.Spec.InstallNamespace,
+		Node:              convertNodeConfigFromV1beta1(src.Spec.Node),
+		Falcon:            convertFalconSensorFromV1beta1(src.Spec.Falcon),
+		FalconAPI:         convertFalconAPIFromV1beta1(src.Spec.FalconAPI),
+		ImageVerification: (*ImageVerificationSpec)(src.Spec.ImageVerification),
+	}
+	if src.Spec.Registry != nil {
+		registry := convertRegistryFromV1beta1(*src.Spec.Registry)
+		dst.Spec.Registry = &registry
+	}
+
+	dst.Status = FalconNodeSensorStatus{
+		Sensor:                 src.Status.Sensor,
+		ImageDigest:            src.Status.ImageDigest,
+		PendingSensor:          src.Status.PendingSensor,
+		PendingSince:           src.Status.PendingSince,
+		Version:                src.Status.Version,
+		DesiredNumberScheduled: src.Status.DesiredNumberScheduled,
+		NumberReady:            src.Status.NumberReady,
+		UpdatedNumberScheduled: src.Status.UpdatedNumberScheduled,
+		NumberUnavailable:      src.Status.NumberUnavailable,
+		Conditions:             src.Status.Conditions,
+	}
+	if src.Status.Architectures != nil {
+		dst.Status.Architectures = make([]FalconNodeArchitectureStatus, 0, len(src.Status.Architectures))
+		for _, arch := range src.Status.Architectures {
+			dst.Status.Architectures = append(dst.Status.Architectures, FalconNodeArchitectureStatus{
+				Architecture:           NodeArchitecture(arch.Architecture),
+				DaemonSet:              arch.DaemonSet,
+				Sensor:                 arch.Sensor,
+				ImageDigest:            arch.ImageDigest,
+				Image:                  arch.Image,
+				DesiredNumberScheduled: arch.DesiredNumberScheduled,
+				NumberReady:            arch.NumberReady,
+			})
+		}
+	}
+
+	return nil
+}
+
+func convertNodeConfigToV1beta1(src FalconNodeSensorConfig) v1beta1.FalconNodeSensorConfig {
+	dst := v1beta1.FalconNodeSensorConfig{
+		Tolerations:                    src.Tolerations,
+		NodeAffinity:                   src.NodeAffinity,
+		ImagePullPolicy:                src.ImagePullPolicy,
+		Image:                          src.Image,
+		ImagePullSecrets:               src.ImagePullSecrets,
+		UpdateStrategy:                 v1beta1.FalconNodeUpdateStrategy(src.DSUpdateStrategy),
+		TerminationGracePeriod:         src.TerminationGracePeriod,
+		ServiceAccount:                 v1beta1.FalconNodeServiceAccount(src.ServiceAccount),
+		DisableCleanup:                 src.NodeCleanup,
+		CleanupTimeout:                 src.CleanupTimeout,
+		Backend:                        src.Backend,
+		Version:                        src.Version,
+		AdditionalEnvironmentVariables: src.AdditionalEnvironmentVariables,
+	}
+	if src.UpdatePolicy != nil {
+		dst.UpdatePolicy = &v1beta1.FalconNodeUpdatePolicy{
+			Mode:              v1beta1.UpdatePolicyMode(src.UpdatePolicy.Mode),
+			SoakPeriod:        src.UpdatePolicy.SoakPeriod,
+			MaintenanceWindow: (*v1beta1.FalconMaintenanceWindow)(src.UpdatePolicy.MaintenanceWindow),
+			CheckInterval:     src.UpdatePolicy.CheckInterval,
+		}
+	}
+	if src.Architectures != nil {
+		dst.Architectures = make([]v1beta1.NodeArchitecture, 0, len(src.Architectures))
+		for _, arch := range src.Architectures {
+			dst.Architectures = append(dst.Architectures, v1beta1.NodeArchitecture(arch))
+		}
+	}
+	return dst
+}
+
+func convertNodeConfigFromV1beta1(src v1beta1.FalconNodeSensorConfig) FalconNodeSensorConfig {
+	dst := FalconNodeSensorConfig{
+		Tolerations:                    src.Tolerations,
+		NodeAffinity:                   src.NodeAffinity,
+		ImagePullPolicy:                src.ImagePullPolicy,
+		Image:                          src.Image,
+		ImagePullSecrets:               src.ImagePullSecrets,
+		DSUpdateStrategy:               FalconNodeUpdateStrategy(src.UpdateStrategy),
+		TerminationGracePeriod:         src.TerminationGracePeriod,
+		ServiceAccount:                 FalconNodeServiceAccount(src.ServiceAccount),
+		NodeCleanup:                    src.DisableCleanup,
+		CleanupTimeout:                 src.CleanupTimeout,
+		Backend:                        src.Backend,
+		Version:                        src.Version,
+		AdditionalEnvironmentVariables: src.AdditionalEnvironmentVariables,
+	}
+	if src.UpdatePolicy != nil {
+		dst.UpdatePolicy = &FalconNodeUpdatePolicy{
+			Mode:              UpdatePolicyMode(src.UpdatePolicy.Mode),
+			SoakPeriod:        src.UpdatePolicy.SoakPeriod,
+			MaintenanceWindow: (*FalconMaintenanceWindow)(src.UpdatePolicy.MaintenanceWindow),
+			CheckInterval:     src.UpdatePolicy.CheckInterval,
+		}
+	}
+	if src.Architectures != nil {
+		dst.Architectures = make([]NodeArchitecture, 0, len(src.Architectures))
+		for _, arch := range src.Architectures {
+			dst.Architectures = append(dst.Architectures, NodeArchitecture(arch))
+		}
+	}
+	return dst
+}
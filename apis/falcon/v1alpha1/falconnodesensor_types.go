@@ -15,6 +15,14 @@ type FalconNodeSensorSpec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
+	// Namespace where the Falcon Sensor should be installed.
+	// For best security practices, this should be a dedicated namespace that is not used for any other purpose.
+	// +kubebuilder:default:=falcon-system
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Install Namespace",order=4
+	InstallNamespace string `json:"installNamespace,omitempty"`
+
 	// Various configuration for DaemonSet Deployment
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DaemonSet Configuration",order=3
 	Node FalconNodeSensorConfig `json:"node,omitempty"`
@@ -27,6 +35,15 @@ type FalconNodeSensorSpec struct {
 	// If using the API is not desired, the sensor can be manually configured.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Platform API Configuration",order=1
 	FalconAPI *FalconAPI `json:"falcon_api,omitempty"`
+
+	// Verify the signature of the Falcon Sensor image before the DaemonSet is created or updated
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Image Verification",order=5
+	ImageVerification *ImageVerificationSpec `json:"imageVerification,omitempty"`
+
+	// Registry the Falcon Sensor image is mirrored to. The DaemonSets deploy the mirrored copy, pinned to its digest, so that the
+	// nodes do not pull from the CrowdStrike registry. Requires falcon_api. Not applicable when node.image is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Image Registry",order=6
+	Registry *RegistrySpec `json:"registry,omitempty"`
 }
 
 // FalconNodeSensorConfig defines aspects about how the daemonset works.
@@ -47,7 +64,7 @@ type FalconNodeSensorConfig struct {
 	// +kubebuilder:validation:Pattern="^.*:.*$"
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
 	Image string `json:"image,omitempty"`
-	// ImagePullSecrets is an optional list of references to secrets in the falcon-system namespace to use for pulling image from image_override location.
+	// ImagePullSecrets is an optional list of references to secrets in the install namespace to use for pulling image from image_override location.
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
 	// Type of DaemonSet update. Can be "RollingUpdate" or "OnDelete". Default is RollingUpdate.
@@ -65,6 +82,11 @@ type FalconNodeSensorConfig struct {
 	// +kubebuilder:default=false
 	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=8
 	NodeCleanup *bool `json:"disableCleanup,omitempty"`
+	// Gives up waiting for the node cleanup after a specified amount of time (in seconds). Default is 300 seconds.
+	// +kubebuilder:default:=300
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=10
+	CleanupTimeout int64 `json:"cleanupTimeout,omitempty"`
 	// Sets the backend to be used by the DaemonSet Sensor.
 	// +kubebuilder:default=kernel
 	// +kubebuilder:validation:Enum=kernel;bpf
@@ -72,7 +94,84 @@ type FalconNodeSensorConfig struct {
 	Backend string `json:"backend,omitempty"`
 
 	// Version of the sensor to be installed. The latest version will be selected when this version specifier is missing.
+	// Either a version prefix such as 6.45 or 6.45.0-14203, a constraint such as ~6.45 or >=6.40 <7, or a release line such as N-1.
 	Version *string `json:"version,omitempty"`
+
+	// Policy for adopting new Falcon Sensor releases from the CrowdStrike registry. When missing, the newest version
+	// matching Version is rolled out as soon as it is found.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Update Policy",order=11
+	UpdatePolicy *FalconNodeUpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// Node architectures to deploy the Falcon Sensor to. One DaemonSet is deployed for each architecture. Sensors from the
+	// CrowdStrike registry run the same release on all architectures; an image set with Image must be a multi-arch image.
+	// +kubebuilder:default:={amd64}
+	// +kubebuilder:validation:MinItems=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Architectures",order=12
+	Architectures []NodeArchitecture `json:"architectures,omitempty"`
+
+	// Additional environment variables for the Falcon Sensor, for instance FALCONCTL_OPT_* options not covered by falcon.
+	// Names are upper-cased and take precedence over the variables set by the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Additional Environment Variables",order=13
+	AdditionalEnvironmentVariables *map[string]string `json:"additionalEnvironmentVariables,omitempty"`
+}
+
+// NodeArchitecture is the value of the kubernetes.io/arch label of the nodes
+// +kubebuilder:validation:Enum=amd64;arm64
+type NodeArchitecture string
+
+const (
+	NodeArchitectureAMD64 NodeArchitecture = "amd64"
+	NodeArchitectureARM64 NodeArchitecture = "arm64"
+)
+
+type UpdatePolicyMode string
+
+const (
+	// UpdatePolicyPinned keeps the installed version until Version no longer matches it
+	UpdatePolicyPinned UpdatePolicyMode = "pinned"
+	// UpdatePolicyLatest follows the newest release
+	UpdatePolicyLatest UpdatePolicyMode = "latest"
+	// UpdatePolicyNMinus1 follows the newest release of the release line before the newest one
+	UpdatePolicyNMinus1 UpdatePolicyMode = "n-1"
+	// UpdatePolicyNMinus2 follows the newest release of the second release line before the newest one
+	UpdatePolicyNMinus2 UpdatePolicyMode = "n-2"
+)
+
+// FalconNodeUpdatePolicy controls when a newer Falcon Sensor release is rolled out to the nodes
+type FalconNodeUpdatePolicy struct {
+	// Which release to follow. Can be "pinned", "latest", "n-1" or "n-2".
+	// +kubebuilder:default=latest
+	// +kubebuilder:validation:Enum=pinned;latest;n-1;n-2
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Mode UpdatePolicyMode `json:"mode,omitempty"`
+
+	// Minimum time a new release must have been seen by the operator before it is rolled out, for instance 72h.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	SoakPeriod *metav1.Duration `json:"soakPeriod,omitempty"`
+
+	// Restricts rollouts of new releases to a recurring maintenance window. Rollouts can start at any time when missing.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
+	MaintenanceWindow *FalconMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// How often the CrowdStrike registry is checked for new releases. Default is 1h.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=4
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+}
+
+// FalconMaintenanceWindow is a recurring period of time during which the sensor may be updated
+type FalconMaintenanceWindow struct {
+	// Cron expression (minute hour day-of-month month day-of-week) in UTC at which the window opens, for instance "0 2 * * 6".
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Schedule string `json:"schedule"`
+
+	// How long the window stays open. Default is 1h.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	Duration *metav1.Duration `json:"duration,omitempty"`
 }
 
 type FalconNodeUpdateStrategy struct {
@@ -96,21 +195,87 @@ type FalconNodeSensorStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	// Phase or the status of the deployment
 
-	// Version of the CrowdStrike Falcon Sensor
+	// Version of the CrowdStrike Falcon Sensor being rolled out by the DaemonSet of the first node architecture
 	Sensor *string `json:"sensor,omitempty"`
 
+	// Manifest digest of the Falcon Sensor image being rolled out by the DaemonSet of the first node architecture
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Newer Falcon Sensor version selected by the update policy that waits for the soak period or the maintenance window
+	// +optional
+	PendingSensor string `json:"pendingSensor,omitempty"`
+
+	// Time at which the operator first found the pending Falcon Sensor version
+	// +optional
+	PendingSince *metav1.Time `json:"pendingSince,omitempty"`
+
 	// Version of the CrowdStrike Falcon Operator
 	Version string `json:"version,omitempty"`
 
+	// Number of nodes that should be running the Falcon Sensor
+	// +optional
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+
+	// Number of nodes running a ready Falcon Sensor pod
+	// +optional
+	NumberReady int32 `json:"numberReady"`
+
+	// Number of nodes running the current Falcon Sensor version
+	// +optional
+	UpdatedNumberScheduled int32 `json:"updatedNumberScheduled"`
+
+	// Number of nodes that should be running the Falcon Sensor but have no available pod
+	// +optional
+	NumberUnavailable int32 `json:"numberUnavailable"`
+
+	// Falcon Sensor version and rollout of each node architecture
+	// +optional
+	Architectures []FalconNodeArchitectureStatus `json:"architectures,omitempty"`
+
 	// Conditions represent the latest available observations of an object's state
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// FalconNodeArchitectureStatus is the observed state of the DaemonSet deploying the Falcon Sensor to one node architecture
+type FalconNodeArchitectureStatus struct {
+	// Node architecture
+	Architecture NodeArchitecture `json:"architecture"`
+
+	// Name of the DaemonSet deploying the Falcon Sensor to the nodes of this architecture
+	DaemonSet string `json:"daemonSet"`
+
+	// Version of the CrowdStrike Falcon Sensor being rolled out to the nodes of this architecture
+	// +optional
+	Sensor string `json:"sensor,omitempty"`
+
+	// Manifest digest of the Falcon Sensor image being rolled out to the nodes of this architecture
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Falcon Sensor image deployed to the nodes of this architecture. The node cleanup runs the same image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Number of nodes of this architecture that should be running the Falcon Sensor
+	// +optional
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+
+	// Number of nodes of this architecture running a ready Falcon Sensor pod
+	// +optional
+	NumberReady int32 `json:"numberReady"`
+}
+
 //+kubebuilder:object:root=true
 //+kubebuilder:subresource:status
+//+kubebuilder:deprecatedversion:warning="falcon.crowdstrike.com/v1alpha1 is deprecated, use falcon.crowdstrike.com/v1beta1"
 //+kubebuilder:resource:scope=Cluster
 //+kubebuilder:printcolumn:name="Operator Version",type="string",JSONPath=".status.version",description="Version of the Operator"
 //+kubebuilder:printcolumn:name="Falcon Sensor",type="string",JSONPath=".status.sensor",description="Version of the Falcon Sensor"
+//+kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".status.desiredNumberScheduled",description="Number of nodes that should run the Falcon Sensor"
+//+kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.numberReady",description="Number of nodes running a ready Falcon Sensor"
+//+kubebuilder:printcolumn:name="Up-To-Date",type="integer",JSONPath=".status.updatedNumberScheduled",description="Number of nodes running the current Falcon Sensor version"
+//+kubebuilder:printcolumn:name="Unavailable",type="integer",JSONPath=".status.numberUnavailable",description="Number of nodes without an available Falcon Sensor"
 
 // FalconNodeSensor is the Schema for the falconnodesensors API
 // +k8s:openapi-gen=true
@@ -211,14 +211,19 @@
 package v1alpha1
 
 import (
-	"k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FalconAPI) DeepCopyInto(out *FalconAPI) {
 	*out = *in
+	if in.SecretRef != nil {
+		in, out := &in.SecretRef, &out.SecretRef
+		*out = new(FalconAPISecretRef)
+		**out = **in
+	}
 	if in.CID != nil {
 		in, out := &in.CID, &out.CID
 		*out = new(string)
@@ -236,6 +241,36 @@ func (in *FalconAPI) DeepCopy() *FalconAPI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FalconAPISecretRef) DeepCopyInto(out *FalconAPISecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconAPISecretRef.
+func (in *FalconAPISecretRef) DeepCopy() *FalconAPISecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FalconAPISecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FalconCertManagerIssuerRef) DeepCopyInto(out *FalconCertManagerIssuerRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconCertManagerIssuerRef.
+func (in *FalconCertManagerIssuerRef) DeepCopy() *FalconCertManagerIssuerRef {
+	if in == nil {
+		return nil
+	}
+	out := new(FalconCertManagerIssuerRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FalconContainer) DeepCopyInto(out *FalconContainer) {
 	*out = *in
@@ -263,6 +298,26 @@ func (in *FalconContainer) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FalconContainerCertManager) DeepCopyInto(out *FalconContainerCertManager) {
+	*out = *in
+	if in.IssuerRef != nil {
+		in, out := &in.IssuerRef, &out.IssuerRef
+		*out = new(FalconCertManagerIssuerRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconContainerCertManager.
+func (in *FalconContainerCertManager) DeepCopy() *FalconContainerCertManager {
+	if in == nil {
+		return nil
+	}
+	out := new(FalconContainerCertManager)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FalconContainerInjectorSpec) DeepCopyInto(out *FalconContainerInjectorSpec) {
 	*out = *in
@@ -275,17 +330,17 @@ func (in *FalconContainerInjectorSpec) DeepCopyInto(out *FalconContainerInjector
 	in.TLS.DeepCopyInto(&out.TLS)
 	if in.LogVolume != nil {
 		in, out := &in.LogVolume, &out.LogVolume
-		*out = new(v1.Volume)
+		*out = new(corev1.Volume)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
 		in, out := &in.Resources, &out.Resources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.SensorResources != nil {
 		in, out := &in.SensorResources, &out.SensorResources
-		*out = new(v1.ResourceRequirements)
+		*out = new(corev1.ResourceRequirements)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.AdditionalEnvironmentVariables != nil {
@@ -304,6 +359,7 @@ func (in *FalconContainerInjectorSpec) DeepCopyInto(out *FalconContainerInjector
 		*out = new(int32)
 		**out = **in
 	}
+	in.Webhook.DeepCopyInto(&out.Webhook)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconContainerInjectorSpec.
@@ -324,6 +380,16 @@ func (in *FalconContainerInjectorTLS) DeepCopyInto(out *FalconContainerInjectorT
 		*out = new(int)
 		**out = **in
 	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(int)
+		**out = **in
+	}
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(FalconContainerCertManager)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconContainerInjectorTLS.
@@ -401,6 +467,11 @@ func (in *FalconContainerSpec) DeepCopyInto(out *FalconContainerSpec) {
 	}
 	in.Registry.DeepCopyInto(&out.Registry)
 	in.Injector.DeepCopyInto(&out.Injector)
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(ImageVerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Image != nil {
 		in, out := &in.Image, &out.Image
 		*out = new(string)
@@ -411,6 +482,11 @@ func (in *FalconContainerSpec) DeepCopyInto(out *FalconContainerSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconContainerSpec.
@@ -431,9 +507,17 @@ func (in *FalconContainerStatus) DeepCopyInto(out *FalconContainerStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.LastImageRefresh != nil {
+		in, out := &in.LastImageRefresh, &out.LastImageRefresh
+		*out = (*in).DeepCopy()
+	}
+	if in.WebhookCAExpiry != nil {
+		in, out := &in.WebhookCAExpiry, &out.WebhookCAExpiry
+		*out = (*in).DeepCopy()
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -450,6 +534,76 @@ func (in *FalconContainerStatus) DeepCopy() *FalconContainerStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FalconContainerWebhook) DeepCopyInto(out *FalconContainerWebhook) {
+	*out = *in
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectSelector != nil {
+		in, out := &in.ObjectSelector, &out.ObjectSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconContainerWebhook.
+func (in *FalconContainerWebhook) DeepCopy() *FalconContainerWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(FalconContainerWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FalconMaintenanceWindow) DeepCopyInto(out *FalconMaintenanceWindow) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconMaintenanceWindow.
+func (in *FalconMaintenanceWindow) DeepCopy() *FalconMaintenanceWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(FalconMaintenanceWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FalconNodeArchitectureStatus) DeepCopyInto(out *FalconNodeArchitectureStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconNodeArchitectureStatus.
+func (in *FalconNodeArchitectureStatus) DeepCopy() *FalconNodeArchitectureStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FalconNodeArchitectureStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FalconNodeSensor) DeepCopyInto(out *FalconNodeSensor) {
 	*out = *in
@@ -482,7 +636,7 @@ func (in *FalconNodeSensorConfig) DeepCopyInto(out *FalconNodeSensorConfig) {
 	*out = *in
 	if in.Tolerations != nil {
 		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+		*out = make([]corev1.Toleration, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -490,7 +644,7 @@ func (in *FalconNodeSensorConfig) DeepCopyInto(out *FalconNodeSensorConfig) {
 	in.NodeAffinity.DeepCopyInto(&out.NodeAffinity)
 	if in.ImagePullSecrets != nil {
 		in, out := &in.ImagePullSecrets, &out.ImagePullSecrets
-		*out = make([]v1.LocalObjectReference, len(*in))
+		*out = make([]corev1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
 	in.DSUpdateStrategy.DeepCopyInto(&out.DSUpdateStrategy)
@@ -505,6 +659,27 @@ func (in *FalconNodeSensorConfig) DeepCopyInto(out *FalconNodeSensorConfig) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.UpdatePolicy != nil {
+		in, out := &in.UpdatePolicy, &out.UpdatePolicy
+		*out = new(FalconNodeUpdatePolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]NodeArchitecture, len(*in))
+		copy(*out, *in)
+	}
+	if in.AdditionalEnvironmentVariables != nil {
+		in, out := &in.AdditionalEnvironmentVariables, &out.AdditionalEnvironmentVariables
+		*out = new(map[string]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make(map[string]string, len(*in))
+			for key, val := range *in {
+				(*out)[key] = val
+			}
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconNodeSensorConfig.
@@ -559,6 +734,16 @@ func (in *FalconNodeSensorSpec) DeepCopyInto(out *FalconNodeSensorSpec) {
 		*out = new(FalconAPI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(ImageVerificationSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Registry != nil {
+		in, out := &in.Registry, &out.Registry
+		*out = new(RegistrySpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconNodeSensorSpec.
@@ -579,9 +764,18 @@ func (in *FalconNodeSensorStatus) DeepCopyInto(out *FalconNodeSensorStatus) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PendingSince != nil {
+		in, out := &in.PendingSince, &out.PendingSince
+		*out = (*in).DeepCopy()
+	}
+	if in.Architectures != nil {
+		in, out := &in.Architectures, &out.Architectures
+		*out = make([]FalconNodeArchitectureStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]metav1.Condition, len(*in))
+		*out = make([]v1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -620,6 +814,36 @@ func (in *FalconNodeServiceAccount) DeepCopy() *FalconNodeServiceAccount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FalconNodeUpdatePolicy) DeepCopyInto(out *FalconNodeUpdatePolicy) {
+	*out = *in
+	if in.SoakPeriod != nil {
+		in, out := &in.SoakPeriod, &out.SoakPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaintenanceWindow != nil {
+		in, out := &in.MaintenanceWindow, &out.MaintenanceWindow
+		*out = new(FalconMaintenanceWindow)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CheckInterval != nil {
+		in, out := &in.CheckInterval, &out.CheckInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconNodeUpdatePolicy.
+func (in *FalconNodeUpdatePolicy) DeepCopy() *FalconNodeUpdatePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FalconNodeUpdatePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FalconNodeUpdateStrategy) DeepCopyInto(out *FalconNodeUpdateStrategy) {
 	*out = *in
@@ -659,6 +883,21 @@ func (in *FalconSensor) DeepCopyInto(out *FalconSensor) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Feature != nil {
+		in, out := &in.Feature, &out.Feature
+		*out = make([]FalconSensorFeature, len(*in))
+		copy(*out, *in)
+	}
+	if in.MessageLog != nil {
+		in, out := &in.MessageLog, &out.MessageLog
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Update != nil {
+		in, out := &in.Update, &out.Update
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FalconSensor.
@@ -671,6 +910,41 @@ func (in *FalconSensor) DeepCopy() *FalconSensor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerificationSpec) DeepCopyInto(out *ImageVerificationSpec) {
+	*out = *in
+	if in.CosignPublicKeys != nil {
+		in, out := &in.CosignPublicKeys, &out.CosignPublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerificationSpec.
+func (in *ImageVerificationSpec) DeepCopy() *ImageVerificationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerificationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RegistryPushSecretRef) DeepCopyInto(out *RegistryPushSecretRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistryPushSecretRef.
+func (in *RegistryPushSecretRef) DeepCopy() *RegistryPushSecretRef {
+	if in == nil {
+		return nil
+	}
+	out := new(RegistryPushSecretRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
 	*out = *in
@@ -680,6 +954,11 @@ func (in *RegistrySpec) DeepCopyInto(out *RegistrySpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PushSecretRef != nil {
+		in, out := &in.PushSecretRef, &out.PushSecretRef
+		*out = new(RegistryPushSecretRef)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RegistrySpec.
@@ -0,0 +1,65 @@
+package v1beta1
+
+const (
+	// Following strings are condition types
+
+	ConditionSuccess         string = "Success"
+	ConditionFailed          string = "Failed"
+	ConditionPending         string = "Pending"
+	ConditionImageReady      string = "ImageReady"
+	ConditionConfigMapReady  string = "ConfigMapReady"
+	ConditionDaemonSetReady  string = "DaemonSetReady"
+	ConditionDeploymentReady string = "DeploymentReady"
+	ConditionServiceReady    string = "ServiceReady"
+	ConditionRouteReady      string = "RouteReady"
+	ConditionSecretReady     string = "SecretReady"
+	ConditionWebhookReady    string = "WebhookReady"
+	ConditionFinalizing      string = "Finalizing"
+	ConditionReady           string = "Ready"
+	ConditionImageVerified   string = "ImageVerified"
+
+	ConditionNodeSensorReady      string = "NodeSensorReady"
+	ConditionContainerSensorReady string = "ContainerSensorReady"
+
+	// Following strings are condition reasons
+
+	ReasonReqNotMet        string = "RequirementsNotMet"
+	ReasonReqMet           string = "RequirementsMet"
+	ReasonInstallSucceeded string = "InstallSucceeded"
+	ReasonInstallFailed    string = "InstallFailed"
+	ReasonSucceeded        string = "Succeeded"
+	ReasonUpdateSucceeded  string = "UpdateSucceeded"
+	ReasonUpdateFailed     string = "UpdateFailed"
+	ReasonFailed           string = "Failed"
+	ReasonDiscovered       string = "Discovered"
+
+	// Following strings are rollout reasons
+
+	ReasonRolloutInProgress string = "RolloutInProgress"
+	ReasonRolloutComplete   string = "RolloutComplete"
+	ReasonCertExpired       string = "CertificateExpired"
+
+	// Following strings are image signature verification reasons
+
+	ReasonVerificationSucceeded string = "VerificationSucceeded"
+	ReasonVerificationFailed    string = "VerificationFailed"
+
+	// Following strings are finalization progress reasons
+
+	ReasonFinalizeStarted       string = "FinalizeStarted"
+	ReasonWebhookRemoved        string = "WebhookRemoved"
+	ReasonPullSecretsRemoved    string = "PullSecretsRemoved"
+	ReasonImageArtifactsRemoved string = "ImageArtifactsRemoved"
+	ReasonNamespaceRemoved      string = "NamespaceRemoved"
+	ReasonSensorRemoved         string = "SensorRemoved"
+	ReasonCleanupRunning        string = "CleanupRunning"
+	ReasonCleanupSucceeded      string = "CleanupSucceeded"
+	ReasonCleanupTimedOut       string = "CleanupTimedOut"
+	ReasonFinalizeFailed        string = "FinalizeFailed"
+	ReasonImageArtifactsKept    string = "ImageArtifactsKept"
+
+	// Following strings are Event reasons
+
+	ReasonSensorVersionChanged string = "SensorVersionChanged"
+	ReasonImageDigestChanged   string = "ImageDigestChanged"
+)
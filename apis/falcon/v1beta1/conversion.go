@@ -0,0 +1,9 @@
+package v1beta1
+
+// v1beta1 is the storage version of the Falcon resources. The other versions convert to and from it.
+
+// Hub marks FalconContainer as the conversion hub
+func (*FalconContainer) Hub() {}
+
+// Hub marks FalconNodeSensor as the conversion hub
+func (*FalconNodeSensor) Hub() {}
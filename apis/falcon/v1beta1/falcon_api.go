@@ -0,0 +1,210 @@
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/falcon-operator/pkg/falcon_api"
+	"github.com/crowdstrike/falcon-operator/version"
+	"github.com/crowdstrike/gofalcon/falcon"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// FalconAPIClientIdKey is the key under which the Falcon OAuth2 API Client ID is stored in the Secret referenced by FalconAPI.SecretRef
+	FalconAPIClientIdKey = "falcon-client-id"
+	// FalconAPIClientSecretKey is the key under which the Falcon OAuth2 API Client Secret is stored in the Secret referenced by FalconAPI.SecretRef
+	FalconAPIClientSecretKey = "falcon-client-secret"
+)
+
+// FalconAPI configures connection from your local Falcon operator to CrowdStrike Falcon platform.
+type FalconAPI struct {
+	// Cloud Region defines CrowdStrike Falcon Cloud Region to which the operator will connect and register.
+	// +kubebuilder:validation:Enum=autodiscover;us-1;us-2;eu-1;us-gov-1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="CrowdStrike Falcon Cloud Region",order=3
+	CloudRegion string `json:"cloudRegion"`
+	// Falcon OAuth2 API Client ID
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client ID",order=1
+	ClientID string `json:"clientID,omitempty"`
+	// Falcon OAuth2 API Client Secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Secret",order=2
+	ClientSecret string `json:"clientSecret,omitempty"`
+	// Reference to a Secret containing the Falcon OAuth2 API credentials under the falcon-client-id and falcon-client-secret keys.
+	// When set, it takes precedence over clientID and clientSecret.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Client Credentials Secret",order=5
+	SecretRef *SecretReference `json:"secretRef,omitempty"`
+	// Falcon Customer ID (CID) Override (optional, default is derived from the API Key pair)
+	// +kubebuilder:validation:Pattern="^[0-9a-fA-F]{32}-[0-9a-fA-F]{2}$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Customer ID (CID)",order=4
+	CID *string `json:"cid,omitempty"`
+}
+
+// SecretReference references a Secret by name and namespace
+type SecretReference struct {
+	// Name of the Secret
+	Name string `json:"name"`
+	// Namespace of the Secret. Required for the Falcon API credentials; the registry push credentials default to the install namespace.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// CrowdStrike Falcon Sensor configuration settings.
+// +k8s:openapi-gen=true
+type FalconSensor struct {
+	// Falcon Customer ID (CID)
+	// +kubebuilder:validation:Pattern:="^[0-9a-fA-F]{32}-[0-9a-fA-F]{2}$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Customer ID (CID)",order=1
+	CID *string `json:"cid,omitempty"`
+	// Disable the Falcon Sensor's use of a proxy.
+	// +kubebuilder:default:=false
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Disable Falcon Proxy",order=3
+	APD *bool `json:"apd,omitempty"`
+	// The application proxy host to use for Falcon sensor proxy configuration.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Disable Falcon Proxy Host",order=4
+	APH string `json:"aph,omitempty"`
+	// The application proxy port to use for Falcon sensor proxy configuration.
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=65535
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Proxy Port",order=5
+	APP *int `json:"app,omitempty"`
+	// Utilize default or Pay-As-You-Go billing.
+	// +kubebuilder:validation:Enum:=default;metered
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Billing",order=8
+	Billing string `json:"billing,omitempty"`
+	// Installation token that prevents unauthorized hosts from being accidentally or maliciously added to your customer ID (CID).
+	// +kubebuilder:validation:Pattern:="^[0-9a-fA-F]{8}$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Provisioning Token",order=2
+	ProvisioningToken string `json:"provisioningToken,omitempty"`
+	// Sensor grouping tags are optional, user-defined identifiers that can used to group and filter hosts. Allowed characters: all alphanumerics, '/', '-', and '_'.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sensor Grouping Tags",order=6
+	Tags []string `json:"tags,omitempty"`
+	// Set sensor trace level.
+	// +kubebuilder:validation:Enum:=none;err;warn;info;debug
+	// +kubebuilder:default:=none
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Trace Level",order=7
+	Trace string `json:"trace,omitempty"`
+	// Sensor features to turn on or off. none clears the features set before.
+	// +kubebuilder:validation:MaxItems:=4
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Sensor Features",order=9
+	Features []FalconSensorFeature `json:"features,omitempty"`
+	// Log sensor messages to the system log of the host.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Message Log",order=10
+	MessageLog *bool `json:"messageLog,omitempty"`
+	// Overwrite the options of a sensor that was configured before, rather than keeping the values set at its first start.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Update Sensor Options",order=11
+	Update *bool `json:"update,omitempty"`
+}
+
+// FalconSensorFeature is a sensor feature set with falconctl --feature
+// +kubebuilder:validation:Enum:=none;enableLog;disableLogBuffer;disableOsfm
+type FalconSensorFeature string
+
+const (
+	FalconSensorFeatureNone             FalconSensorFeature = "none"
+	FalconSensorFeatureEnableLog        FalconSensorFeature = "enableLog"
+	FalconSensorFeatureDisableLogBuffer FalconSensorFeature = "disableLogBuffer"
+	FalconSensorFeatureDisableOsfm      FalconSensorFeature = "disableOsfm"
+)
+
+// RegistryTLSSpec configures TLS for registry pushing
+type RegistryTLSSpec struct {
+	// Allow pushing to docker registries over HTTPS with failed TLS verification. Note that this does not affect other TLS connections.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Skip Registry TLS Verification",order=1
+	InsecureSkipVerify bool `json:"insecureSkipVerify,omitempty"`
+	// Allow for users to provide a CA Cert Bundle, as either a string or base64 encoded string
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Registry CA Certificate Bundle; optionally (double) base64 encoded",order=2
+	CACertificate string `json:"caCertificate,omitempty"`
+	// Allow for users to provide a ConfigMap containing a CA Cert Bundle under a key ending in .crt
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="ConfigMap containing Registry CA Certificate Bundle",order=3
+	CACertificateConfigMap string `json:"caCertificateConfigMap,omitempty"`
+}
+
+type RegistryTypeSpec string
+
+const (
+	// RegistryTypeOpenshift represents OpenShift Image Stream
+	RegistryTypeOpenshift RegistryTypeSpec = "openshift"
+	// RegistryTypeGCR represents Google Container Registry
+	RegistryTypeGCR RegistryTypeSpec = "gcr"
+	// RegistryTypeECR represents AWS Elastic Container Registry
+	RegistryTypeECR RegistryTypeSpec = "ecr"
+	// RegistryTypeACR represents Azure Container Registry
+	RegistryTypeACR RegistryTypeSpec = "acr"
+	// RegistryTypeCrowdStrike represents deployment that won't push Falcon Container to local registry, instead CrowdStrike registry will be used.
+	RegistryTypeCrowdStrike RegistryTypeSpec = "crowdstrike"
+	// RegistryTypeGeneric represents any OCI registry, such as Harbor or Artifactory, given by URI and PushSecretRef
+	RegistryTypeGeneric RegistryTypeSpec = "generic"
+)
+
+// RegistrySpec configures container image registry to which the Falcon Container image will be pushed
+type RegistrySpec struct {
+	// Type of the registry to be used
+	// +kubebuilder:validation:Enum=acr;ecr;gcr;crowdstrike;openshift;generic
+	Type RegistryTypeSpec `json:"type"`
+
+	// TLS configures TLS connection for push of the Falcon image to the registry
+	TLS RegistryTLSSpec `json:"tls,omitempty"`
+	// Azure Container Registry Name represents the name of the ACR for the Falcon image push. Only applicable to Azure cloud.
+	ACRName *string `json:"acrName,omitempty"`
+	// Repository the Falcon image is pushed to, for instance harbor.example.com/falcon/falcon-container. Only applicable to the generic registry type.
+	URI string `json:"uri,omitempty"`
+	// Reference to a Secret of type kubernetes.io/dockerconfigjson holding the credentials for pushing to URI. Only applicable to the generic registry type.
+	PushSecretRef *SecretReference `json:"pushSecretRef,omitempty"`
+}
+
+// ImageVerificationSpec configures signature verification of the Falcon sensor image before it is mirrored or rolled out
+type ImageVerificationSpec struct {
+	// PEM encoded cosign public keys. The image must carry a sigstore signature, as created by cosign, made with one of these keys.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cosign Public Keys",order=1
+	CosignPublicKeys []string `json:"cosignPublicKeys,omitempty"`
+	// A containers-policy.json document the image must satisfy. Takes precedence over cosignPublicKeys.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Signature Policy (containers-policy.json)",order=2
+	Policy string `json:"policy,omitempty"`
+}
+
+// ApiConfig generates standard gofalcon library api config. Credentials are read through the supplied client when SecretRef is set.
+func (fa *FalconAPI) ApiConfig(ctx context.Context, cli client.Reader) (*falcon.ApiConfig, error) {
+	clientId := fa.ClientID
+	clientSecret := fa.ClientSecret
+
+	if fa.SecretRef != nil {
+		if cli == nil {
+			return nil, fmt.Errorf("Cannot read Falcon API credentials from Secret %s/%s: no Kubernetes client available", fa.SecretRef.Namespace, fa.SecretRef.Name)
+		}
+
+		secret := &corev1.Secret{}
+		err := cli.Get(ctx, types.NamespacedName{Name: fa.SecretRef.Name, Namespace: fa.SecretRef.Namespace}, secret)
+		if err != nil {
+			return nil, fmt.Errorf("Cannot read Falcon API credentials from Secret %s/%s: %v", fa.SecretRef.Namespace, fa.SecretRef.Name, err)
+		}
+
+		clientId = string(secret.Data[FalconAPIClientIdKey])
+		clientSecret = string(secret.Data[FalconAPIClientSecretKey])
+		if clientId == "" || clientSecret == "" {
+			return nil, fmt.Errorf("Secret %s/%s must contain both %s and %s keys", fa.SecretRef.Namespace, fa.SecretRef.Name, FalconAPIClientIdKey, FalconAPIClientSecretKey)
+		}
+	}
+
+	return &falcon.ApiConfig{
+		Cloud:             falcon.Cloud(fa.CloudRegion),
+		ClientId:          clientId,
+		ClientSecret:      clientSecret,
+		UserAgentOverride: fmt.Sprintf("falcon-operator/%s", version.Version),
+		Context:           ctx,
+	}, nil
+}
+
+// ReferencesSecret returns true when the Falcon API credentials are read from the given Secret
+func (fa *FalconAPI) ReferencesSecret(namespace, name string) bool {
+	return fa.SecretRef != nil && fa.SecretRef.Namespace == namespace && fa.SecretRef.Name == name
+}
+
+func (fa *FalconAPI) FalconCloud(ctx context.Context, cli client.Reader) (falcon.CloudType, error) {
+	apiConfig, err := fa.ApiConfig(ctx, cli)
+	if err != nil {
+		return falcon.Cloud(fa.CloudRegion), err
+	}
+	return falcon_api.FalconCloud(ctx, apiConfig)
+}
@@ -0,0 +1,126 @@
+package v1beta1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestApiConfig(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	credentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "falcon-api-credentials", Namespace: "falcon-operator"},
+		Data: map[string][]byte{
+			FalconAPIClientIdKey:     []byte("secret-id"),
+			FalconAPIClientSecretKey: []byte("secret-secret"),
+		},
+	}
+	incomplete := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "incomplete-credentials", Namespace: "falcon-operator"},
+		Data: map[string][]byte{
+			FalconAPIClientIdKey: []byte("secret-id"),
+		},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(credentials, incomplete).Build()
+
+	tests := []struct {
+		name         string
+		api          FalconAPI
+		cli          client.Reader
+		clientId     string
+		clientSecret string
+		err          string
+	}{
+		{
+			name:         "inline credentials",
+			api:          FalconAPI{CloudRegion: "us-1", ClientID: "inline-id", ClientSecret: "inline-secret"},
+			cli:          cli,
+			clientId:     "inline-id",
+			clientSecret: "inline-secret",
+		},
+		{
+			name:         "inline credentials without client",
+			api:          FalconAPI{CloudRegion: "us-1", ClientID: "inline-id", ClientSecret: "inline-secret"},
+			clientId:     "inline-id",
+			clientSecret: "inline-secret",
+		},
+		{
+			name:         "secret reference",
+			api:          FalconAPI{CloudRegion: "us-1", SecretRef: &SecretReference{Name: "falcon-api-credentials", Namespace: "falcon-operator"}},
+			cli:          cli,
+			clientId:     "secret-id",
+			clientSecret: "secret-secret",
+		},
+		{
+			name: "secret reference takes precedence over inline credentials",
+			api: FalconAPI{
+				CloudRegion:  "us-1",
+				ClientID:     "inline-id",
+				ClientSecret: "inline-secret",
+				SecretRef:    &SecretReference{Name: "falcon-api-credentials", Namespace: "falcon-operator"},
+			},
+			cli:          cli,
+			clientId:     "secret-id",
+			clientSecret: "secret-secret",
+		},
+		{
+			name: "missing secret",
+			api: FalconAPI{
+				CloudRegion:  "us-1",
+				ClientID:     "inline-id",
+				ClientSecret: "inline-secret",
+				SecretRef:    &SecretReference{Name: "missing", Namespace: "falcon-operator"},
+			},
+			cli: cli,
+			err: "Cannot read Falcon API credentials from Secret falcon-operator/missing",
+		},
+		{
+			name: "secret in another namespace",
+			api:  FalconAPI{CloudRegion: "us-1", SecretRef: &SecretReference{Name: "falcon-api-credentials", Namespace: "default"}},
+			cli:  cli,
+			err:  "Cannot read Falcon API credentials from Secret default/falcon-api-credentials",
+		},
+		{
+			name: "missing key",
+			api:  FalconAPI{CloudRegion: "us-1", SecretRef: &SecretReference{Name: "incomplete-credentials", Namespace: "falcon-operator"}},
+			cli:  cli,
+			err:  "must contain both falcon-client-id and falcon-client-secret keys",
+		},
+		{
+			name: "secret reference without client",
+			api:  FalconAPI{CloudRegion: "us-1", SecretRef: &SecretReference{Name: "falcon-api-credentials", Namespace: "falcon-operator"}},
+			err:  "no Kubernetes client available",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := tt.api.ApiConfig(context.Background(), tt.cli)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("ApiConfig() error = %v, want error containing %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ApiConfig() error = %v", err)
+			}
+			if config.ClientId != tt.clientId || config.ClientSecret != tt.clientSecret {
+				t.Errorf("ApiConfig() credentials = %s/%s, want %s/%s", config.ClientId, config.ClientSecret, tt.clientId, tt.clientSecret)
+			}
+			if config.Cloud.String() != tt.api.CloudRegion {
+				t.Errorf("ApiConfig() cloud = %s, want %s", config.Cloud.String(), tt.api.CloudRegion)
+			}
+		})
+	}
+}
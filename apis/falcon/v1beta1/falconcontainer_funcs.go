@@ -0,0 +1,63 @@
+package v1beta1
+
+import "time"
+
+const (
+	defaultContainerNamespace = "falcon-system"
+
+	// MinRefreshInterval is the shortest interval at which the Falcon Container image is looked up, so that the Falcon API
+	// and the registry are not polled continuously
+	MinRefreshInterval = time.Minute
+)
+
+// TargetNs returns a namespace to which the injector should be installed to
+func (fc *FalconContainer) TargetNs() string {
+	if fc.Spec.InstallNamespace != "" {
+		return fc.Spec.InstallNamespace
+	}
+	return defaultContainerNamespace
+}
+
+// InjectorListenPort returns the port the injector listens on
+func (fc *FalconContainer) InjectorListenPort() int32 {
+	if fc.Spec.Injector.ListenPort != nil {
+		return *fc.Spec.Injector.ListenPort
+	}
+	return defaultInjectorListenPort
+}
+
+// InjectorReplicas returns the number of injector replicas
+func (fc *FalconContainer) InjectorReplicas() int32 {
+	if fc.Spec.Injector.Replicas != nil {
+		return *fc.Spec.Injector.Replicas
+	}
+	return defaultInjectorReplicas
+}
+
+// InjectorTLSValidity returns the validity of the injector TLS certificate in days
+func (fc *FalconContainer) InjectorTLSValidity() int {
+	if fc.Spec.Injector.TLS.Validity != nil {
+		return *fc.Spec.Injector.TLS.Validity
+	}
+	return defaultInjectorTLSValidity
+}
+
+// InjectorTLSRenewBefore returns how many days before its expiry the injector TLS certificate is renewed
+func (fc *FalconContainer) InjectorTLSRenewBefore() int {
+	if fc.Spec.Injector.TLS.RenewBefore != nil {
+		return *fc.Spec.Injector.TLS.RenewBefore
+	}
+	return defaultInjectorRenewBefore
+}
+
+// ImageRefreshInterval returns how often the Falcon Container image is looked up, or 0 when it is only looked up when the
+// FalconContainer is reconciled. Intervals below MinRefreshInterval, which the validating webhook rejects, are raised to it.
+func (fc *FalconContainer) ImageRefreshInterval() time.Duration {
+	if fc.Spec.RefreshInterval == nil || fc.Spec.RefreshInterval.Duration <= 0 {
+		return 0
+	}
+	if fc.Spec.RefreshInterval.Duration < MinRefreshInterval {
+		return MinRefreshInterval
+	}
+	return fc.Spec.RefreshInterval.Duration
+}
@@ -0,0 +1,277 @@
+package v1beta1
+
+import (
+	arv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// FalconContainerSpec defines the desired state of FalconContainer
+// +k8s:openapi-gen=true
+type FalconContainerSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Namespace where the Falcon Container Injector should be installed.
+	// For best security practices, this should be a dedicated namespace that is not used for any other purpose.
+	// +kubebuilder:default:=falcon-system
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector Install Namespace",order=7
+	InstallNamespace string `json:"installNamespace,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Configuration",order=1
+	Falcon FalconSensor `json:"falcon,omitempty"`
+	// FalconAPI configures connection from your local Falcon operator to CrowdStrike Falcon platform.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Platform API Configuration",order=2
+	FalconAPI *FalconAPI `json:"falconAPI,omitempty"`
+
+	// Registry configures container image registry to which the Falcon Container image will be pushed
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Image Registry Configuration",order=3
+	Registry RegistrySpec `json:"registry,omitempty"`
+
+	// Injector represents additional configuration for Falcon Container Injector
+	// +kubebuilder:default:={imagePullPolicy:Always}
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector Configuration",order=4
+	Injector FalconContainerInjectorSpec `json:"injector,omitempty"`
+
+	// Verify the signature of the Falcon Container image before it is mirrored or rolled out
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Image Verification",order=8
+	ImageVerification *ImageVerificationSpec `json:"imageVerification,omitempty"`
+
+	// +kubebuilder:validation:Pattern="^.*:.*$"
+	// +operator-sdk:cv:customresourcedefinitions:type=spec,displayName="Falcon Container Image URI",order=5
+	Image *string `json:"image,omitempty"`
+
+	// Falcon Container Version. The latest version will be selected when version specifier is missing; ignored when Image is set.
+	// Either a version prefix such as 6.45 or 6.45.0-2201, a constraint such as ~6.45 or >=6.40 <7, or a release line such as N-1.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Image Version",order=6
+	Version *string `json:"version,omitempty"`
+
+	// How often the registry is checked for a newer Falcon Container image matching Version, for instance 6h. A newer image is
+	// mirrored and handed to the injector. When missing, the image is only looked up when the FalconContainer is reconciled.
+	// Ignored when Image is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Image Refresh Interval",order=9
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+type FalconContainerInjectorSpec struct {
+	// Define annotations that will be passed down to injector service account. This is useful for passing along AWS IAM Role or GCP Workload Identity.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Service Account Configuration",order=1
+	ServiceAccount FalconContainerServiceAccount `json:"serviceAccount,omitempty"`
+
+	// +kubebuilder:default:=4433
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=65535
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector Listen Port",order=2
+	ListenPort *int32 `json:"listenPort,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector TLS Configuration",order=3
+	TLS FalconContainerInjectorTLS `json:"tls,omitempty"`
+
+	// +kubebuilder:default:=Always
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Image Pull Policy",order=4
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+
+	// +kubebuilder:default=crowdstrike-falcon-pull-secret
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Image Pull Secret Name",order=5
+	ImagePullSecretName string `json:"imagePullSecret,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Shared Log Volume",order=6
+	LogVolume *corev1.Volume `json:"logVolume,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector Resources",order=7
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Sensor Resources",order=8
+	SensorResources *corev1.ResourceRequirements `json:"sensorResources,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Additional Environment Variables",order=9
+	AdditionalEnvironmentVariables *map[string]string `json:"additionalEnvironmentVariables,omitempty"`
+
+	// +kubebuilder:default=false
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Disable Default Namespace Injection",order=10
+	DisableDefaultNSInjection bool `json:"disableDefaultNamespaceInjection,omitempty"`
+
+	// +kubebuilder:default=false
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Disable Default Pod Injection",order=11
+	DisableDefaultPodInjection bool `json:"disableDefaultPodInjection,omitempty"`
+
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Azure Config file path",order=12
+	AzureConfigPath string `json:"azureConfigPath,omitempty"`
+
+	// +kubebuilder:default:=2
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Minimum:=0
+	// +kubebuilder:validation:Maximum:=65535
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Injector replica count",order=13
+	Replicas *int32 `json:"replicas,omitempty"`
+
+	// Configure which namespaces and pods are sent to the injector MutatingWebhookConfiguration and how admission failures are handled
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector Webhook Configuration",order=14
+	Webhook FalconContainerWebhook `json:"webhook,omitempty"`
+}
+
+type FalconContainerWebhook struct {
+	// Additional namespace selector requirements; they are combined with the injection label selector
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Webhook Namespace Selector",order=1
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// Restrict injection to pods matching this label selector
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Webhook Object Selector",order=2
+	ObjectSelector *metav1.LabelSelector `json:"objectSelector,omitempty"`
+
+	// How errors calling the injector are handled. Ignore admits pods without the Falcon Container sensor when the injector is unavailable.
+	// +kubebuilder:default=Fail
+	// +kubebuilder:validation:Enum=Fail;Ignore
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Webhook Failure Policy",order=3
+	FailurePolicy arv1.FailurePolicyType `json:"failurePolicy,omitempty"`
+
+	// Timeout in seconds for calls to the injector
+	// +kubebuilder:default:=30
+	// +kubebuilder:validation:Minimum:=1
+	// +kubebuilder:validation:Maximum:=30
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Webhook Timeout Seconds",order=4
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// Whether the injector is called again when other admission plugins modify the pod
+	// +kubebuilder:default=Never
+	// +kubebuilder:validation:Enum=Never;IfNeeded
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Webhook Reinvocation Policy",order=5
+	ReinvocationPolicy arv1.ReinvocationPolicyType `json:"reinvocationPolicy,omitempty"`
+
+	// Namespaces that are never injected, regardless of their labels
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Webhook Excluded Namespaces",order=6
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+}
+
+type FalconContainerServiceAccount struct {
+	// Define annotations that will be passed down to the Service Account. This is useful for passing along AWS IAM Role or GCP Workload Identity.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type FalconContainerInjectorTLS struct {
+	// Validity of the injector TLS certificate in days. Default is 3650 days.
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:default:=3650
+	// +kubebuilder:validation:Pattern="^[0-9]{1,4}$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector TLS Validity Length (days)",order=1
+	Validity *int `json:"validity,omitempty"`
+
+	// Renew the injector TLS certificate when it expires within this many days. The window is capped at half of the certificate lifetime. Default is 30 days.
+	// +kubebuilder:default:=30
+	// +kubebuilder:validation:Minimum=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector TLS Renewal Window (days)",order=2
+	RenewBefore *int `json:"renewBefore,omitempty"`
+
+	// Use cert-manager to issue the injector TLS certificate instead of generating it in the operator. Requires cert-manager to be installed on the cluster.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector cert-manager Configuration",order=3
+	CertManager *FalconContainerCertManager `json:"certManager,omitempty"`
+
+	// Key algorithm of the injector CA and serving certificate. Changing it regenerates the certificate. Default is RSA-2048.
+	// +kubebuilder:default=RSA-2048
+	// +kubebuilder:validation:Enum=RSA-2048;RSA-3072;RSA-4096;ECDSA-P256;ECDSA-P384
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Injector TLS Key Algorithm",order=4
+	KeyAlgorithm string `json:"keyAlgorithm,omitempty"`
+}
+
+type FalconContainerCertManager struct {
+	// Issuer signing the injector certificate. A self-signed Issuer is created in the install namespace when omitted.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="cert-manager Issuer Reference",order=1
+	IssuerRef *FalconCertManagerIssuerRef `json:"issuerRef,omitempty"`
+}
+
+type FalconCertManagerIssuerRef struct {
+	// Name of the issuer
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Kind of the issuer
+	// +kubebuilder:default=Issuer
+	// +kubebuilder:validation:Enum=Issuer;ClusterIssuer
+	Kind string `json:"kind,omitempty"`
+
+	// API group of the issuer
+	// +kubebuilder:default=cert-manager.io
+	Group string `json:"group,omitempty"`
+}
+
+// FalconContainerStatus defines the observed state of FalconContainer
+// +k8s:openapi-gen=true
+type FalconContainerStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Version of the CrowdStrike Falcon Sensor
+	Sensor *string `json:"sensor,omitempty"`
+
+	// Manifest digest of the Falcon Container image the injector is deployed with
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Time of the last periodic lookup of the Falcon Container image
+	// +optional
+	LastImageRefresh *metav1.Time `json:"lastImageRefresh,omitempty"`
+
+	// Version of the CrowdStrike Falcon Operator
+	Version string `json:"version,omitempty"`
+
+	// Number of injector replicas desired by the injector Deployment
+	// +optional
+	InjectorReplicas int32 `json:"injectorReplicas"`
+
+	// Number of ready injector replicas
+	// +optional
+	InjectorReadyReplicas int32 `json:"injectorReadyReplicas"`
+
+	// Expiry of the CA certificate trusted by the injector MutatingWebhookConfiguration
+	// +optional
+	WebhookCAExpiry *metav1.Time `json:"webhookCAExpiry,omitempty"`
+
+	// Name of the ECR repository the operator created to mirror the Falcon Container image to. Only this repository is deleted
+	// with the FalconContainer, and only when it holds no images anymore.
+	// +optional
+	CreatedECRRepository string `json:"createdECRRepository,omitempty"`
+
+	// Number of registry pull token Secrets removed from namespaces that no longer qualify for injection
+	// +optional
+	PrunedPullSecrets int32 `json:"prunedPullSecrets,omitempty"`
+
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Operator Version",type="string",JSONPath=".status.version",description="Version of the Operator"
+//+kubebuilder:printcolumn:name="Falcon Sensor",type="string",JSONPath=".status.sensor",description="Version of the Falcon Container"
+
+// FalconContainer is the Schema for the falconcontainers API
+type FalconContainer struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FalconContainerSpec   `json:"spec,omitempty"`
+	Status FalconContainerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FalconContainerList contains a list of FalconContainer
+type FalconContainerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FalconContainer `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FalconContainer{}, &FalconContainerList{})
+}
@@ -0,0 +1,85 @@
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const (
+	defaultInjectorListenPort  = 4433
+	defaultInjectorReplicas    = 2
+	defaultInjectorTLSValidity = 3650
+	defaultInjectorRenewBefore = 30
+)
+
+// SetupWebhookWithManager registers the defaulting and validating webhooks of FalconContainer
+func (fc *FalconContainer) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(fc).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-falcon-crowdstrike-com-v1beta1-falconcontainer,mutating=true,failurePolicy=fail,sideEffects=None,groups=falcon.crowdstrike.com,resources=falconcontainers,verbs=create;update,versions=v1beta1,name=mfalconcontainer.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &FalconContainer{}
+
+// Default sets the defaults of the fields the controller relies on. The controller also applies it to FalconContainers
+// created before the webhook was installed.
+func (fc *FalconContainer) Default() {
+	injector := &fc.Spec.Injector
+	if injector.ListenPort == nil {
+		port := int32(defaultInjectorListenPort)
+		injector.ListenPort = &port
+	}
+	if injector.Replicas == nil {
+		replicas := int32(defaultInjectorReplicas)
+		injector.Replicas = &replicas
+	}
+	if injector.TLS.Validity == nil {
+		validity := defaultInjectorTLSValidity
+		injector.TLS.Validity = &validity
+	}
+	if injector.TLS.RenewBefore == nil {
+		renewBefore := defaultInjectorRenewBefore
+		injector.TLS.RenewBefore = &renewBefore
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-falcon-crowdstrike-com-v1beta1-falconcontainer,mutating=false,failurePolicy=fail,sideEffects=None,groups=falcon.crowdstrike.com,resources=falconcontainers,verbs=create;update,versions=v1beta1,name=vfalconcontainer.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &FalconContainer{}
+
+// ValidateCreate rejects a FalconContainer with invalid settings or settings that cannot be combined
+func (fc *FalconContainer) ValidateCreate() error {
+	return fc.validate()
+}
+
+// ValidateUpdate rejects an update to invalid settings or settings that cannot be combined
+func (fc *FalconContainer) ValidateUpdate(old runtime.Object) error {
+	return fc.validate()
+}
+
+// ValidateDelete allows every deletion
+func (fc *FalconContainer) ValidateDelete() error {
+	return nil
+}
+
+func (fc *FalconContainer) validate() error {
+	spec := field.NewPath("spec")
+	allErrs := validateFalconSensor(&fc.Spec.Falcon, spec.Child("falcon"))
+	allErrs = append(allErrs, validateCIDSource(fc.Spec.FalconAPI, &fc.Spec.Falcon, spec.Child("falconAPI"), spec.Child("falcon", "cid"))...)
+	allErrs = append(allErrs, validateRegistry(&fc.Spec.Registry, spec.Child("registry"))...)
+
+	allErrs = append(allErrs, validateInjector(&fc.Spec.Injector, spec.Child("injector"))...)
+	if fc.Spec.RefreshInterval != nil {
+		allErrs = append(allErrs, validateRefreshInterval(fc.Spec.RefreshInterval, spec.Child("refreshInterval"))...)
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("FalconContainer").GroupKind(), fc.Name, allErrs)
+}
@@ -0,0 +1,124 @@
+package v1beta1
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFalconContainerDefault(t *testing.T) {
+	fc := &FalconContainer{}
+	fc.Default()
+
+	port := int32(4433)
+	replicas := int32(2)
+	validity := 3650
+	renewBefore := 30
+	want := FalconContainerInjectorSpec{
+		ListenPort: &port,
+		Replicas:   &replicas,
+		TLS:        FalconContainerInjectorTLS{Validity: &validity, RenewBefore: &renewBefore},
+	}
+	if diff := cmp.Diff(want, fc.Spec.Injector); diff != "" {
+		t.Errorf("Default() mismatch (-want +got): %s", diff)
+	}
+
+	port, replicas, validity, renewBefore = 8443, 3, 365, 7
+	fc = &FalconContainer{Spec: FalconContainerSpec{Injector: *want.DeepCopy()}}
+	want = *fc.Spec.Injector.DeepCopy()
+	fc.Default()
+	if diff := cmp.Diff(want, fc.Spec.Injector); diff != "" {
+		t.Errorf("Default() overrode the settings (-want +got): %s", diff)
+	}
+}
+
+func TestFalconContainerInjectorAccessors(t *testing.T) {
+	fc := &FalconContainer{}
+	defaulted := fc.DeepCopy()
+	defaulted.Default()
+	if got, want := fc.InjectorListenPort(), *defaulted.Spec.Injector.ListenPort; got != want {
+		t.Errorf("InjectorListenPort() = %d, want %d", got, want)
+	}
+	if got, want := fc.InjectorReplicas(), *defaulted.Spec.Injector.Replicas; got != want {
+		t.Errorf("InjectorReplicas() = %d, want %d", got, want)
+	}
+	if got, want := fc.InjectorTLSValidity(), *defaulted.Spec.Injector.TLS.Validity; got != want {
+		t.Errorf("InjectorTLSValidity() = %d, want %d", got, want)
+	}
+	if got, want := fc.InjectorTLSRenewBefore(), *defaulted.Spec.Injector.TLS.RenewBefore; got != want {
+		t.Errorf("InjectorTLSRenewBefore() = %d, want %d", got, want)
+	}
+
+	port, replicas, validity, renewBefore := int32(8443), int32(3), 365, 7
+	fc.Spec.Injector = FalconContainerInjectorSpec{
+		ListenPort: &port,
+		Replicas:   &replicas,
+		TLS:        FalconContainerInjectorTLS{Validity: &validity, RenewBefore: &renewBefore},
+	}
+	if fc.InjectorListenPort() != port || fc.InjectorReplicas() != replicas || fc.InjectorTLSValidity() != validity || fc.InjectorTLSRenewBefore() != renewBefore {
+		t.Errorf("accessors ignored the settings: %d %d %d %d", fc.InjectorListenPort(), fc.InjectorReplicas(), fc.InjectorTLSValidity(), fc.InjectorTLSRenewBefore())
+	}
+}
+
+func TestFalconContainerImageRefreshInterval(t *testing.T) {
+	tests := []struct {
+		interval *metav1.Duration
+		want     time.Duration
+	}{
+		{nil, 0},
+		{&metav1.Duration{}, 0},
+		{&metav1.Duration{Duration: time.Second}, MinRefreshInterval},
+		{&metav1.Duration{Duration: 6 * time.Hour}, 6 * time.Hour},
+	}
+
+	for _, tt := range tests {
+		fc := &FalconContainer{Spec: FalconContainerSpec{RefreshInterval: tt.interval}}
+		if got := fc.ImageRefreshInterval(); got != tt.want {
+			t.Errorf("ImageRefreshInterval() with %v = %v, want %v", tt.interval, got, tt.want)
+		}
+	}
+}
+
+func TestFalconContainerValidate(t *testing.T) {
+	cid := testCID
+	zero := 0
+	api := &FalconAPI{ClientID: "id", ClientSecret: "secret"}
+	crowdstrike := RegistrySpec{Type: RegistryTypeCrowdStrike}
+
+	tests := []struct {
+		name      string
+		spec      FalconContainerSpec
+		wantField string
+	}{
+		{"falcon api", FalconContainerSpec{FalconAPI: api, Registry: crowdstrike}, ""},
+		{"cid with related image", FalconContainerSpec{Falcon: FalconSensor{CID: &cid}}, ""},
+		{"generic registry", FalconContainerSpec{FalconAPI: api, Registry: RegistrySpec{Type: RegistryTypeGeneric, URI: "harbor.example.com/falcon/falcon-sensor", PushSecretRef: &SecretReference{Name: "push"}}}, ""},
+		{"no cid source", FalconContainerSpec{Registry: crowdstrike}, "spec.falcon.cid"},
+		{"generic without push secret", FalconContainerSpec{FalconAPI: api, Registry: RegistrySpec{Type: RegistryTypeGeneric, URI: "harbor.example.com/falcon/falcon-sensor"}}, "spec.registry.pushSecretRef.name"},
+		{"push secret for openshift", FalconContainerSpec{FalconAPI: api, Registry: RegistrySpec{Type: RegistryTypeOpenshift, PushSecretRef: &SecretReference{Name: "push"}}}, "spec.registry.pushSecretRef"},
+		{"zero validity", FalconContainerSpec{FalconAPI: api, Registry: crowdstrike, Injector: FalconContainerInjectorSpec{TLS: FalconContainerInjectorTLS{Validity: &zero}}}, "spec.injector.tls.validity"},
+		{"issuer without name", FalconContainerSpec{FalconAPI: api, Registry: crowdstrike, Injector: FalconContainerInjectorSpec{TLS: FalconContainerInjectorTLS{CertManager: &FalconContainerCertManager{IssuerRef: &FalconCertManagerIssuerRef{Kind: "ClusterIssuer"}}}}}, "spec.injector.tls.certManager.issuerRef.name"},
+		{"zero refresh interval", FalconContainerSpec{FalconAPI: api, Registry: crowdstrike, RefreshInterval: &metav1.Duration{}}, "spec.refreshInterval"},
+		{"refresh interval", FalconContainerSpec{FalconAPI: api, Registry: crowdstrike, RefreshInterval: &metav1.Duration{Duration: time.Minute}}, ""},
+		{"refresh interval below minimum", FalconContainerSpec{FalconAPI: api, Registry: crowdstrike, RefreshInterval: &metav1.Duration{Duration: time.Second}}, "spec.refreshInterval"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fc := &FalconContainer{ObjectMeta: metav1.ObjectMeta{Name: "falcon-sidecar-sensor"}, Spec: tt.spec}
+			err := fc.ValidateCreate()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("ValidateCreate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantField+":") {
+				t.Errorf("ValidateCreate() error = %v, want an error for %s", err, tt.wantField)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package v1beta1
+
+const defaultDeploymentContainerNamespace = "falcon-sidecar"
+
+// NodeSensorEnabled reports whether the FalconDeployment creates a FalconNodeSensor
+func (fd *FalconDeployment) NodeSensorEnabled() bool {
+	return fd.Spec.NodeSensor.Enabled == nil || *fd.Spec.NodeSensor.Enabled
+}
+
+// ContainerSensorEnabled reports whether the FalconDeployment creates a FalconContainer
+func (fd *FalconDeployment) ContainerSensorEnabled() bool {
+	return fd.Spec.ContainerSensor.Enabled == nil || *fd.Spec.ContainerSensor.Enabled
+}
+
+// NodeSensorNs returns a namespace to which the node sensor should be installed to
+func (fd *FalconDeployment) NodeSensorNs() string {
+	if fd.Spec.NodeSensor.InstallNamespace != "" {
+		return fd.Spec.NodeSensor.InstallNamespace
+	}
+	return defaultNodeSensorNamespace
+}
+
+// ContainerSensorNs returns a namespace to which the injector should be installed to. It differs from the default
+// namespace of the node sensor, the FalconContainer and the FalconNodeSensor each remove their namespace when deleted.
+func (fd *FalconDeployment) ContainerSensorNs() string {
+	if fd.Spec.ContainerSensor.InstallNamespace != "" {
+		return fd.Spec.ContainerSensor.InstallNamespace
+	}
+	return defaultDeploymentContainerNamespace
+}
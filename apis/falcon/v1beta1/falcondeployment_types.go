@@ -0,0 +1,139 @@
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FalconDeploymentSpec defines the desired state of FalconDeployment
+// +k8s:openapi-gen=true
+type FalconDeploymentSpec struct {
+	// FalconAPI configures connection from your local Falcon operator to CrowdStrike Falcon platform. It is shared by the node and
+	// container sensors.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Platform API Configuration",order=1
+	FalconAPI *FalconAPI `json:"falconAPI,omitempty"`
+
+	// Falcon Sensor settings shared by the node and container sensors
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Configuration",order=2
+	Falcon FalconSensor `json:"falcon,omitempty"`
+
+	// Registry the Falcon Sensor images are mirrored to. The CrowdStrike registry is used directly when missing.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Image Registry",order=3
+	Registry *RegistrySpec `json:"registry,omitempty"`
+
+	// Verify the signature of the Falcon Sensor images before they are mirrored or rolled out
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Image Verification",order=4
+	ImageVerification *ImageVerificationSpec `json:"imageVerification,omitempty"`
+
+	// Falcon Sensor DaemonSet for the nodes that can run it
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Node Sensor Configuration",order=5
+	NodeSensor FalconDeploymentNodeSensor `json:"nodeSensor,omitempty"`
+
+	// Falcon Container sidecar injection for the pods on nodes that cannot run the Falcon Sensor DaemonSet
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Container Sensor Configuration",order=6
+	ContainerSensor FalconDeploymentContainerSensor `json:"containerSensor,omitempty"`
+}
+
+// FalconDeploymentNodeSensor configures the FalconNodeSensor created by a FalconDeployment
+type FalconDeploymentNodeSensor struct {
+	// Create the FalconNodeSensor. Default is true.
+	// +kubebuilder:default=true
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Namespace where the Falcon Sensor should be installed. Default is falcon-system.
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	InstallNamespace string `json:"installNamespace,omitempty"`
+
+	// Various configuration for DaemonSet Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
+	Node FalconNodeSensorConfig `json:"node,omitempty"`
+}
+
+// FalconDeploymentContainerSensor configures the FalconContainer created by a FalconDeployment
+type FalconDeploymentContainerSensor struct {
+	// Create the FalconContainer. Default is true.
+	// +kubebuilder:default=true
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Namespace where the Falcon Container Injector should be installed. Default is falcon-sidecar. It must differ from
+	// the namespace of the node sensor.
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	InstallNamespace string `json:"installNamespace,omitempty"`
+
+	// Injector represents additional configuration for Falcon Container Injector
+	// +kubebuilder:default:={imagePullPolicy:Always}
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
+	Injector FalconContainerInjectorSpec `json:"injector,omitempty"`
+
+	// +kubebuilder:validation:Pattern="^.*:.*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=4
+	Image *string `json:"image,omitempty"`
+
+	// Falcon Container Version. The latest version will be selected when version specifier is missing; ignored when Image is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=5
+	Version *string `json:"version,omitempty"`
+
+	// How often the registry is checked for a newer Falcon Container image matching Version, for instance 6h.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=6
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// FalconDeploymentStatus defines the observed state of FalconDeployment
+// +k8s:openapi-gen=true
+type FalconDeploymentStatus struct {
+	// Name of the FalconNodeSensor created for the FalconDeployment
+	// +optional
+	NodeSensor string `json:"nodeSensor,omitempty"`
+
+	// Name of the FalconContainer created for the FalconDeployment
+	// +optional
+	ContainerSensor string `json:"containerSensor,omitempty"`
+
+	// Number of nodes running the Falcon Sensor DaemonSet, which carry the node sensor label and are kept out of sidecar injection
+	// +optional
+	CoveredNodes int32 `json:"coveredNodes"`
+
+	// Version of the CrowdStrike Falcon Operator
+	Version string `json:"version,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Operator Version",type="string",JSONPath=".status.version",description="Version of the Operator"
+//+kubebuilder:printcolumn:name="Node Sensor",type="string",JSONPath=".status.nodeSensor",description="FalconNodeSensor created for the FalconDeployment"
+//+kubebuilder:printcolumn:name="Container Sensor",type="string",JSONPath=".status.containerSensor",description="FalconContainer created for the FalconDeployment"
+//+kubebuilder:printcolumn:name="Covered Nodes",type="integer",JSONPath=".status.coveredNodes",description="Number of nodes running the Falcon Sensor DaemonSet"
+//+kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="Whether all enabled sensors are ready"
+
+// FalconDeployment is the Schema for the falcondeployments API. It deploys the Falcon Sensor to the nodes through a
+// FalconNodeSensor and injects the Falcon Container sensor into the other pods through a FalconContainer.
+// +k8s:openapi-gen=true
+type FalconDeployment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FalconDeploymentSpec   `json:"spec,omitempty"`
+	Status FalconDeploymentStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FalconDeploymentList contains a list of FalconDeployment
+type FalconDeploymentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FalconDeployment `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FalconDeployment{}, &FalconDeploymentList{})
+}
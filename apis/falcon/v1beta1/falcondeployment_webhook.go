@@ -0,0 +1,92 @@
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// SetupWebhookWithManager registers the defaulting and validating webhooks of FalconDeployment
+func (fd *FalconDeployment) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(fd).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-falcon-crowdstrike-com-v1beta1-falcondeployment,mutating=true,failurePolicy=fail,sideEffects=None,groups=falcon.crowdstrike.com,resources=falcondeployments,verbs=create;update,versions=v1beta1,name=mfalcondeployment.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &FalconDeployment{}
+
+// Default sets the defaults of the fields the controller relies on. The controller also applies it to FalconDeployments
+// created before the webhook was installed.
+func (fd *FalconDeployment) Default() {
+	if fd.Spec.NodeSensor.Enabled == nil {
+		enabled := true
+		fd.Spec.NodeSensor.Enabled = &enabled
+	}
+	if fd.Spec.NodeSensor.InstallNamespace == "" {
+		fd.Spec.NodeSensor.InstallNamespace = defaultNodeSensorNamespace
+	}
+	if fd.Spec.ContainerSensor.Enabled == nil {
+		enabled := true
+		fd.Spec.ContainerSensor.Enabled = &enabled
+	}
+	if fd.Spec.ContainerSensor.InstallNamespace == "" {
+		fd.Spec.ContainerSensor.InstallNamespace = defaultDeploymentContainerNamespace
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-falcon-crowdstrike-com-v1beta1-falcondeployment,mutating=false,failurePolicy=fail,sideEffects=None,groups=falcon.crowdstrike.com,resources=falcondeployments,verbs=create;update,versions=v1beta1,name=vfalcondeployment.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &FalconDeployment{}
+
+// ValidateCreate rejects a FalconDeployment with invalid settings or settings that cannot be combined
+func (fd *FalconDeployment) ValidateCreate() error {
+	return fd.validate()
+}
+
+// ValidateUpdate rejects an update to invalid settings or settings that cannot be combined
+func (fd *FalconDeployment) ValidateUpdate(old runtime.Object) error {
+	return fd.validate()
+}
+
+// ValidateDelete allows every deletion
+func (fd *FalconDeployment) ValidateDelete() error {
+	return nil
+}
+
+func (fd *FalconDeployment) validate() error {
+	spec := field.NewPath("spec")
+	allErrs := validateFalconSensor(&fd.Spec.Falcon, spec.Child("falcon"))
+	allErrs = append(allErrs, validateCIDSource(fd.Spec.FalconAPI, &fd.Spec.Falcon, spec.Child("falconAPI"), spec.Child("falcon", "cid"))...)
+
+	if fd.Spec.Registry != nil {
+		allErrs = append(allErrs, validateRegistry(fd.Spec.Registry, spec.Child("registry"))...)
+		if fd.NodeSensorEnabled() && fd.Spec.Registry.Type != RegistryTypeCrowdStrike && fd.Spec.FalconAPI == nil {
+			allErrs = append(allErrs, field.Required(spec.Child("falconAPI"), "image mirroring selects the image from the CrowdStrike registry and requires falconAPI"))
+		}
+	}
+
+	if fd.NodeSensorEnabled() {
+		allErrs = append(allErrs, validateNodeSensorConfig(&fd.Spec.NodeSensor.Node, spec.Child("nodeSensor", "node"))...)
+	}
+
+	if fd.ContainerSensorEnabled() {
+		containerSensor := spec.Child("containerSensor")
+		allErrs = append(allErrs, validateInjector(&fd.Spec.ContainerSensor.Injector, containerSensor.Child("injector"))...)
+		if fd.Spec.ContainerSensor.RefreshInterval != nil {
+			allErrs = append(allErrs, validateRefreshInterval(fd.Spec.ContainerSensor.RefreshInterval, containerSensor.Child("refreshInterval"))...)
+		}
+		// Each sensor removes its namespace when it is deleted
+		if fd.NodeSensorEnabled() && fd.ContainerSensorNs() == fd.NodeSensorNs() {
+			allErrs = append(allErrs, field.Invalid(containerSensor.Child("installNamespace"), fd.ContainerSensorNs(), "must differ from the install namespace of the node sensor"))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("FalconDeployment").GroupKind(), fd.Name, allErrs)
+}
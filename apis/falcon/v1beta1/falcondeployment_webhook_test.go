@@ -0,0 +1,77 @@
+package v1beta1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFalconDeploymentDefault(t *testing.T) {
+	deployment := &FalconDeployment{}
+	deployment.Default()
+
+	enabled := true
+	want := FalconDeploymentSpec{
+		NodeSensor:      FalconDeploymentNodeSensor{Enabled: &enabled, InstallNamespace: "falcon-system"},
+		ContainerSensor: FalconDeploymentContainerSensor{Enabled: &enabled, InstallNamespace: "falcon-sidecar"},
+	}
+	if diff := cmp.Diff(want, deployment.Spec); diff != "" {
+		t.Errorf("Default() mismatch (-want +got): %s", diff)
+	}
+
+	disabled := false
+	deployment = &FalconDeployment{Spec: FalconDeploymentSpec{
+		NodeSensor:      FalconDeploymentNodeSensor{Enabled: &disabled, InstallNamespace: "falcon-node"},
+		ContainerSensor: FalconDeploymentContainerSensor{Enabled: &disabled, InstallNamespace: "falcon-injector"},
+	}}
+	want = *deployment.Spec.DeepCopy()
+	deployment.Default()
+	if diff := cmp.Diff(want, deployment.Spec); diff != "" {
+		t.Errorf("Default() overrode the settings (-want +got): %s", diff)
+	}
+}
+
+func TestFalconDeploymentValidate(t *testing.T) {
+	cid := testCID
+	validity := 0
+	disabled := false
+	api := &FalconAPI{ClientID: "id", ClientSecret: "secret"}
+
+	tests := []struct {
+		name      string
+		spec      FalconDeploymentSpec
+		wantField string
+	}{
+		{"falcon api", FalconDeploymentSpec{FalconAPI: api}, ""},
+		{"cid", FalconDeploymentSpec{Falcon: FalconSensor{CID: &cid}}, ""},
+		{"mirroring", FalconDeploymentSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeECR}}, ""},
+		{"no cid source", FalconDeploymentSpec{}, "spec.falcon.cid"},
+		{"incomplete falcon api", FalconDeploymentSpec{FalconAPI: &FalconAPI{ClientID: "id"}}, "spec.falconAPI.clientSecret"},
+		{"mirroring without falcon api", FalconDeploymentSpec{Falcon: FalconSensor{CID: &cid}, Registry: &RegistrySpec{Type: RegistryTypeECR}}, "spec.falconAPI"},
+		{"acr without name", FalconDeploymentSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeACR}}, "spec.registry.acrName"},
+		{"duplicate architectures", FalconDeploymentSpec{FalconAPI: api, NodeSensor: FalconDeploymentNodeSensor{Node: FalconNodeSensorConfig{Architectures: []NodeArchitecture{NodeArchitectureARM64, NodeArchitectureARM64}}}}, "spec.nodeSensor.node.architectures[1]"},
+		{"disabled node sensor", FalconDeploymentSpec{FalconAPI: api, NodeSensor: FalconDeploymentNodeSensor{Enabled: &disabled, Node: FalconNodeSensorConfig{Architectures: []NodeArchitecture{NodeArchitectureARM64, NodeArchitectureARM64}}}}, ""},
+		{"invalid tls validity", FalconDeploymentSpec{FalconAPI: api, ContainerSensor: FalconDeploymentContainerSensor{Injector: FalconContainerInjectorSpec{TLS: FalconContainerInjectorTLS{Validity: &validity}}}}, "spec.containerSensor.injector.tls.validity"},
+		{"negative refresh interval", FalconDeploymentSpec{FalconAPI: api, ContainerSensor: FalconDeploymentContainerSensor{RefreshInterval: &metav1.Duration{Duration: -1}}}, "spec.containerSensor.refreshInterval"},
+		{"shared namespace", FalconDeploymentSpec{FalconAPI: api, ContainerSensor: FalconDeploymentContainerSensor{InstallNamespace: "falcon-system"}}, "spec.containerSensor.installNamespace"},
+		{"shared namespace without node sensor", FalconDeploymentSpec{FalconAPI: api, NodeSensor: FalconDeploymentNodeSensor{Enabled: &disabled}, ContainerSensor: FalconDeploymentContainerSensor{InstallNamespace: "falcon-system"}}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := &FalconDeployment{ObjectMeta: metav1.ObjectMeta{Name: "falcon"}, Spec: tt.spec}
+			err := deployment.ValidateCreate()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("ValidateCreate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantField+":") {
+				t.Errorf("ValidateCreate() error = %v, want an error for %s", err, tt.wantField)
+			}
+		})
+	}
+}
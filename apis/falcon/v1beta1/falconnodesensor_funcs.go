@@ -0,0 +1,16 @@
+package v1beta1
+
+const defaultNodeSensorNamespace = "falcon-system"
+
+// TargetNs returns a namespace to which the node sensor should be installed to
+func (n *FalconNodeSensor) TargetNs() string {
+	if n.Spec.InstallNamespace != "" {
+		return n.Spec.InstallNamespace
+	}
+	return defaultNodeSensorNamespace
+}
+
+// MirrorsImage reports whether the sensor image selected from the CrowdStrike registry is mirrored to the registry of spec.registry
+func (n *FalconNodeSensor) MirrorsImage() bool {
+	return n.Spec.Node.Image == "" && n.Spec.FalconAPI != nil && n.Spec.Registry != nil && n.Spec.Registry.Type != RegistryTypeCrowdStrike
+}
@@ -0,0 +1,300 @@
+package v1beta1
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
+// NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
+
+// FalconNodeSensorSpec defines the desired state of FalconNodeSensor
+// +k8s:openapi-gen=true
+type FalconNodeSensorSpec struct {
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+
+	// Namespace where the Falcon Sensor should be installed.
+	// For best security practices, this should be a dedicated namespace that is not used for any other purpose.
+	// +kubebuilder:default:=falcon-system
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:MaxLength=63
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Install Namespace",order=4
+	InstallNamespace string `json:"installNamespace,omitempty"`
+
+	// Various configuration for DaemonSet Deployment
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DaemonSet Configuration",order=3
+	Node FalconNodeSensorConfig `json:"node,omitempty"`
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Configuration",order=2
+	Falcon FalconSensor `json:"falcon,omitempty"`
+	// FalconAPI configures connection from your local Falcon operator to CrowdStrike Falcon platform.
+	//
+	// When configured, it will pull the sensor from registry.crowdstrike.com and deploy the appropriate sensor to the cluster.
+	//
+	// If using the API is not desired, the sensor can be manually configured.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Platform API Configuration",order=1
+	FalconAPI *FalconAPI `json:"falconAPI,omitempty"`
+
+	// Verify the signature of the Falcon Sensor image before the DaemonSet is created or updated
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Image Verification",order=5
+	ImageVerification *ImageVerificationSpec `json:"imageVerification,omitempty"`
+
+	// Registry the Falcon Sensor image is mirrored to. The DaemonSets deploy the mirrored copy, pinned to its digest, so that the
+	// nodes do not pull from the CrowdStrike registry. Requires falconAPI. Not applicable when node.image is set.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Image Registry",order=6
+	Registry *RegistrySpec `json:"registry,omitempty"`
+}
+
+// FalconNodeSensorConfig defines aspects about how the daemonset works.
+// +k8s:openapi-gen=true
+type FalconNodeSensorConfig struct {
+	// Specifies tolerations for custom taints. Defaults to allowing scheduling on all nodes.
+	// +kubebuilder:default:={{key: "node-role.kubernetes.io/master", operator: "Exists", effect: "NoSchedule"}, {key: "node-role.kubernetes.io/control-plane", operator: "Exists", effect: "NoSchedule"}}
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=4
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+	// Specifies node affinity for scheduling the DaemonSet. Defaults to allowing scheduling on all nodes.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=5
+	NodeAffinity corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+	// +kubebuilder:default=Always
+	// +kubebuilder:validation:Enum=Always;IfNotPresent;Never
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
+	ImagePullPolicy corev1.PullPolicy `json:"imagePullPolicy,omitempty"`
+	// Location of the Falcon Sensor image. Use only in cases when you mirror the original image to your repository/name:tag
+	// +kubebuilder:validation:Pattern="^.*:.*$"
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	Image string `json:"image,omitempty"`
+	// ImagePullSecrets is an optional list of references to secrets in the install namespace to use for pulling the image set with image.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+	// Type of DaemonSet update. Can be "RollingUpdate" or "OnDelete". Default is RollingUpdate.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="DaemonSet Update Strategy",order=6
+	UpdateStrategy FalconNodeUpdateStrategy `json:"updateStrategy,omitempty"`
+	// Kills pod after a specificed amount of time (in seconds). Default is 30 seconds.
+	// +kubebuilder:default:=30
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=7
+	TerminationGracePeriod int64 `json:"terminationGracePeriod,omitempty"`
+	// Add metadata to the DaemonSet Service Account for IAM roles.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	ServiceAccount FalconNodeServiceAccount `json:"serviceAccount,omitempty"`
+	// Disables the cleanup of the sensor through DaemonSet on the nodes.
+	// Disabling might have unintended consequences for certain operations such as sensor downgrading.
+	// +kubebuilder:default=false
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=8
+	DisableCleanup *bool `json:"disableCleanup,omitempty"`
+	// Gives up waiting for the node cleanup after a specified amount of time (in seconds). Default is 300 seconds.
+	// +kubebuilder:default:=300
+	// +kubebuilder:validation:Minimum=0
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=10
+	CleanupTimeout int64 `json:"cleanupTimeout,omitempty"`
+	// Sets the backend to be used by the DaemonSet Sensor.
+	// +kubebuilder:default=kernel
+	// +kubebuilder:validation:Enum=kernel;bpf
+	// +operator-sdk-csv:customresourcedefinitions:type=spec,order=9
+	Backend string `json:"backend,omitempty"`
+
+	// Version of the sensor to be installed. The latest version will be selected when this version specifier is missing.
+	// Either a version prefix such as 6.45 or 6.45.0-14203, a constraint such as ~6.45 or >=6.40 <7, or a release line such as N-1.
+	Version *string `json:"version,omitempty"`
+
+	// Policy for adopting new Falcon Sensor releases from the CrowdStrike registry. When missing, the newest version
+	// matching Version is rolled out as soon as it is found.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Update Policy",order=11
+	UpdatePolicy *FalconNodeUpdatePolicy `json:"updatePolicy,omitempty"`
+
+	// Node architectures to deploy the Falcon Sensor to. One DaemonSet is deployed for each architecture. Sensors from the
+	// CrowdStrike registry run the same release on all architectures; an image set with Image must be a multi-arch image.
+	// +kubebuilder:default:={amd64}
+	// +kubebuilder:validation:MinItems=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Node Architectures",order=12
+	Architectures []NodeArchitecture `json:"architectures,omitempty"`
+
+	// Additional environment variables for the Falcon Sensor, for instance FALCONCTL_OPT_* options not covered by falcon.
+	// Names are upper-cased and take precedence over the variables set by the operator.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Falcon Sensor Additional Environment Variables",order=13
+	AdditionalEnvironmentVariables *map[string]string `json:"additionalEnvironmentVariables,omitempty"`
+}
+
+// NodeArchitecture is the value of the kubernetes.io/arch label of the nodes
+// +kubebuilder:validation:Enum=amd64;arm64
+type NodeArchitecture string
+
+const (
+	NodeArchitectureAMD64 NodeArchitecture = "amd64"
+	NodeArchitectureARM64 NodeArchitecture = "arm64"
+)
+
+type UpdatePolicyMode string
+
+const (
+	// UpdatePolicyPinned keeps the installed version until Version no longer matches it
+	UpdatePolicyPinned UpdatePolicyMode = "pinned"
+	// UpdatePolicyLatest follows the newest release
+	UpdatePolicyLatest UpdatePolicyMode = "latest"
+	// UpdatePolicyNMinus1 follows the newest release of the release line before the newest one
+	UpdatePolicyNMinus1 UpdatePolicyMode = "n-1"
+	// UpdatePolicyNMinus2 follows the newest release of the second release line before the newest one
+	UpdatePolicyNMinus2 UpdatePolicyMode = "n-2"
+)
+
+// FalconNodeUpdatePolicy controls when a newer Falcon Sensor release is rolled out to the nodes
+type FalconNodeUpdatePolicy struct {
+	// Which release to follow. Can be "pinned", "latest", "n-1" or "n-2".
+	// +kubebuilder:default=latest
+	// +kubebuilder:validation:Enum=pinned;latest;n-1;n-2
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Mode UpdatePolicyMode `json:"mode,omitempty"`
+
+	// Minimum time a new release must have been seen by the operator before it is rolled out, for instance 72h.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	SoakPeriod *metav1.Duration `json:"soakPeriod,omitempty"`
+
+	// Restricts rollouts of new releases to a recurring maintenance window. Rollouts can start at any time when missing.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=3
+	MaintenanceWindow *FalconMaintenanceWindow `json:"maintenanceWindow,omitempty"`
+
+	// How often the CrowdStrike registry is checked for new releases. Default is 1h.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=4
+	CheckInterval *metav1.Duration `json:"checkInterval,omitempty"`
+}
+
+// FalconMaintenanceWindow is a recurring period of time during which the sensor may be updated
+type FalconMaintenanceWindow struct {
+	// Cron expression (minute hour day-of-month month day-of-week) in UTC at which the window opens, for instance "0 2 * * 6".
+	// +kubebuilder:validation:MinLength=1
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=1
+	Schedule string `json:"schedule"`
+
+	// How long the window stays open. Default is 1h.
+	// +optional
+	// +operator-sdk:csv:customresourcedefinitions:type=spec,order=2
+	Duration *metav1.Duration `json:"duration,omitempty"`
+}
+
+type FalconNodeUpdateStrategy struct {
+	// +kubebuilder:default=RollingUpdate
+	// +kubebuilder:validation:Enum=RollingUpdate;OnDelete
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Type          appsv1.DaemonSetUpdateStrategyType `json:"type,omitempty"`
+	RollingUpdate appsv1.RollingUpdateDaemonSet      `json:"rollingUpdate,omitempty"`
+}
+
+type FalconNodeServiceAccount struct {
+	// Define annotations that will be passed down to the Service Account. This is useful for passing along AWS IAM Role or GCP Workload Identity.
+	// +operator-sdk:csv:customresourcedefinitions:type=spec
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// FalconNodeSensorStatus defines the observed state of FalconNodeSensor
+// +k8s:openapi-gen=true
+type FalconNodeSensorStatus struct {
+	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
+	// Phase or the status of the deployment
+
+	// Version of the CrowdStrike Falcon Sensor being rolled out by the DaemonSet of the first node architecture
+	Sensor *string `json:"sensor,omitempty"`
+
+	// Manifest digest of the Falcon Sensor image being rolled out by the DaemonSet of the first node architecture
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Newer Falcon Sensor version selected by the update policy that waits for the soak period or the maintenance window
+	// +optional
+	PendingSensor string `json:"pendingSensor,omitempty"`
+
+	// Time at which the operator first found the pending Falcon Sensor version
+	// +optional
+	PendingSince *metav1.Time `json:"pendingSince,omitempty"`
+
+	// Version of the CrowdStrike Falcon Operator
+	Version string `json:"version,omitempty"`
+
+	// Number of nodes that should be running the Falcon Sensor
+	// +optional
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+
+	// Number of nodes running a ready Falcon Sensor pod
+	// +optional
+	NumberReady int32 `json:"numberReady"`
+
+	// Number of nodes running the current Falcon Sensor version
+	// +optional
+	UpdatedNumberScheduled int32 `json:"updatedNumberScheduled"`
+
+	// Number of nodes that should be running the Falcon Sensor but have no available pod
+	// +optional
+	NumberUnavailable int32 `json:"numberUnavailable"`
+
+	// Falcon Sensor version and rollout of each node architecture
+	// +optional
+	Architectures []FalconNodeArchitectureStatus `json:"architectures,omitempty"`
+
+	// Conditions represent the latest available observations of an object's state
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// FalconNodeArchitectureStatus is the observed state of the DaemonSet deploying the Falcon Sensor to one node architecture
+type FalconNodeArchitectureStatus struct {
+	// Node architecture
+	Architecture NodeArchitecture `json:"architecture"`
+
+	// Name of the DaemonSet deploying the Falcon Sensor to the nodes of this architecture
+	DaemonSet string `json:"daemonSet"`
+
+	// Version of the CrowdStrike Falcon Sensor being rolled out to the nodes of this architecture
+	// +optional
+	Sensor string `json:"sensor,omitempty"`
+
+	// Manifest digest of the Falcon Sensor image being rolled out to the nodes of this architecture
+	// +optional
+	ImageDigest string `json:"imageDigest,omitempty"`
+
+	// Falcon Sensor image deployed to the nodes of this architecture. The node cleanup runs the same image.
+	// +optional
+	Image string `json:"image,omitempty"`
+
+	// Number of nodes of this architecture that should be running the Falcon Sensor
+	// +optional
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled"`
+
+	// Number of nodes of this architecture running a ready Falcon Sensor pod
+	// +optional
+	NumberReady int32 `json:"numberReady"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:storageversion
+//+kubebuilder:resource:scope=Cluster
+//+kubebuilder:printcolumn:name="Operator Version",type="string",JSONPath=".status.version",description="Version of the Operator"
+//+kubebuilder:printcolumn:name="Falcon Sensor",type="string",JSONPath=".status.sensor",description="Version of the Falcon Sensor"
+//+kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".status.desiredNumberScheduled",description="Number of nodes that should run the Falcon Sensor"
+//+kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.numberReady",description="Number of nodes running a ready Falcon Sensor"
+//+kubebuilder:printcolumn:name="Up-To-Date",type="integer",JSONPath=".status.updatedNumberScheduled",description="Number of nodes running the current Falcon Sensor version"
+//+kubebuilder:printcolumn:name="Unavailable",type="integer",JSONPath=".status.numberUnavailable",description="Number of nodes without an available Falcon Sensor"
+
+// FalconNodeSensor is the Schema for the falconnodesensors API
+// +k8s:openapi-gen=true
+type FalconNodeSensor struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              FalconNodeSensorSpec   `json:"spec,omitempty"`
+	Status            FalconNodeSensorStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FalconNodeSensorList contains a list of FalconNodeSensor
+type FalconNodeSensorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FalconNodeSensor `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FalconNodeSensor{}, &FalconNodeSensorList{})
+}
@@ -0,0 +1,76 @@
+package v1beta1
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+const defaultCleanupTimeout = 300
+
+// SetupWebhookWithManager registers the defaulting and validating webhooks of FalconNodeSensor
+func (n *FalconNodeSensor) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(n).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-falcon-crowdstrike-com-v1beta1-falconnodesensor,mutating=true,failurePolicy=fail,sideEffects=None,groups=falcon.crowdstrike.com,resources=falconnodesensors,verbs=create;update,versions=v1beta1,name=mfalconnodesensor.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &FalconNodeSensor{}
+
+// Default sets the defaults of the fields the controller relies on. The controller also applies it to FalconNodeSensors
+// created before the webhook was installed.
+func (n *FalconNodeSensor) Default() {
+	if n.Spec.Node.DisableCleanup == nil {
+		disabled := false
+		n.Spec.Node.DisableCleanup = &disabled
+	}
+	if n.Spec.Node.CleanupTimeout == 0 {
+		n.Spec.Node.CleanupTimeout = defaultCleanupTimeout
+	}
+	if len(n.Spec.Node.Architectures) == 0 {
+		n.Spec.Node.Architectures = []NodeArchitecture{NodeArchitectureAMD64}
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-falcon-crowdstrike-com-v1beta1-falconnodesensor,mutating=false,failurePolicy=fail,sideEffects=None,groups=falcon.crowdstrike.com,resources=falconnodesensors,verbs=create;update,versions=v1beta1,name=vfalconnodesensor.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &FalconNodeSensor{}
+
+// ValidateCreate rejects a FalconNodeSensor with invalid settings or settings that cannot be combined
+func (n *FalconNodeSensor) ValidateCreate() error {
+	return n.validate()
+}
+
+// ValidateUpdate rejects an update to invalid settings or settings that cannot be combined
+func (n *FalconNodeSensor) ValidateUpdate(old runtime.Object) error {
+	return n.validate()
+}
+
+// ValidateDelete allows every deletion
+func (n *FalconNodeSensor) ValidateDelete() error {
+	return nil
+}
+
+func (n *FalconNodeSensor) validate() error {
+	spec := field.NewPath("spec")
+	allErrs := validateFalconSensor(&n.Spec.Falcon, spec.Child("falcon"))
+	allErrs = append(allErrs, validateCIDSource(n.Spec.FalconAPI, &n.Spec.Falcon, spec.Child("falconAPI"), spec.Child("falcon", "cid"))...)
+
+	if n.Spec.Registry != nil {
+		allErrs = append(allErrs, validateRegistry(n.Spec.Registry, spec.Child("registry"))...)
+		if n.Spec.Registry.Type != RegistryTypeCrowdStrike && n.Spec.FalconAPI == nil {
+			allErrs = append(allErrs, field.Required(spec.Child("falconAPI"), "image mirroring selects the image from the CrowdStrike registry and requires falconAPI"))
+		}
+	}
+
+	allErrs = append(allErrs, validateNodeSensorConfig(&n.Spec.Node, spec.Child("node"))...)
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(GroupVersion.WithKind("FalconNodeSensor").GroupKind(), n.Name, allErrs)
+}
@@ -0,0 +1,90 @@
+package v1beta1
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testCID = "0123456789ABCDEF0123456789ABCDEF-12"
+
+func TestFalconNodeSensorDefault(t *testing.T) {
+	nodesensor := &FalconNodeSensor{}
+	nodesensor.Default()
+
+	disabled := false
+	want := FalconNodeSensorConfig{
+		DisableCleanup: &disabled,
+		CleanupTimeout: 300,
+		Architectures:  []NodeArchitecture{NodeArchitectureAMD64},
+	}
+	if diff := cmp.Diff(want, nodesensor.Spec.Node); diff != "" {
+		t.Errorf("Default() mismatch (-want +got): %s", diff)
+	}
+
+	enabled := true
+	nodesensor = &FalconNodeSensor{Spec: FalconNodeSensorSpec{Node: FalconNodeSensorConfig{
+		DisableCleanup: &enabled,
+		CleanupTimeout: 60,
+		Architectures:  []NodeArchitecture{NodeArchitectureARM64},
+	}}}
+	want = *nodesensor.Spec.Node.DeepCopy()
+	nodesensor.Default()
+	if diff := cmp.Diff(want, nodesensor.Spec.Node); diff != "" {
+		t.Errorf("Default() overrode the settings (-want +got): %s", diff)
+	}
+}
+
+func TestFalconNodeSensorValidate(t *testing.T) {
+	cid := testCID
+	invalidCID := "not-a-cid"
+	port := 8080
+	api := &FalconAPI{ClientID: "id", ClientSecret: "secret"}
+
+	tests := []struct {
+		name      string
+		spec      FalconNodeSensorSpec
+		wantField string
+	}{
+		{"cid", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &cid}}, ""},
+		{"falcon api", FalconNodeSensorSpec{FalconAPI: api}, ""},
+		{"image and falcon api", FalconNodeSensorSpec{FalconAPI: api, Node: FalconNodeSensorConfig{Image: "example.com/falcon-sensor:7.01"}}, ""},
+		{"mirroring", FalconNodeSensorSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeECR}}, ""},
+		{"no cid source", FalconNodeSensorSpec{}, "spec.falcon.cid"},
+		{"invalid cid", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &invalidCID}}, "spec.falcon.cid"},
+		{"incomplete falcon api", FalconNodeSensorSpec{FalconAPI: &FalconAPI{ClientID: "id"}}, "spec.falconAPI.clientSecret"},
+		{"incomplete secretRef", FalconNodeSensorSpec{FalconAPI: &FalconAPI{SecretRef: &SecretReference{Name: "falcon-api"}}}, "spec.falconAPI.secretRef.namespace"},
+		{"proxy port without host", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &cid, APP: &port}}, "spec.falcon.aph"},
+		{"invalid provisioning token", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &cid, ProvisioningToken: "token"}}, "spec.falcon.provisioningToken"},
+		{"none with other features", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &cid, Features: []FalconSensorFeature{FalconSensorFeatureNone, FalconSensorFeatureEnableLog}}}, "spec.falcon.features"},
+		{"duplicate features", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &cid, Features: []FalconSensorFeature{FalconSensorFeatureEnableLog, FalconSensorFeatureEnableLog}}}, "spec.falcon.features[1]"},
+		{"mirroring without falcon api", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &cid}, Registry: &RegistrySpec{Type: RegistryTypeECR}}, "spec.falconAPI"},
+		{"acr without name", FalconNodeSensorSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeACR}}, "spec.registry.acrName"},
+		{"generic without uri", FalconNodeSensorSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeGeneric, PushSecretRef: &SecretReference{Name: "push"}}}, "spec.registry.uri"},
+		{"generic uri with tag", FalconNodeSensorSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeGeneric, URI: "harbor.example.com/falcon:latest", PushSecretRef: &SecretReference{Name: "push"}}}, "spec.registry.uri"},
+		{"generic without push secret", FalconNodeSensorSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeGeneric, URI: "harbor.example.com/falcon"}}, "spec.registry.pushSecretRef.name"},
+		{"uri for ecr", FalconNodeSensorSpec{FalconAPI: api, Registry: &RegistrySpec{Type: RegistryTypeECR, URI: "harbor.example.com/falcon"}}, "spec.registry.uri"},
+		{"update policy with image", FalconNodeSensorSpec{Falcon: FalconSensor{CID: &cid}, Node: FalconNodeSensorConfig{Image: "example.com/falcon-sensor:7.01", UpdatePolicy: &FalconNodeUpdatePolicy{}}}, "spec.node.updatePolicy"},
+		{"invalid maintenance window", FalconNodeSensorSpec{FalconAPI: api, Node: FalconNodeSensorConfig{UpdatePolicy: &FalconNodeUpdatePolicy{MaintenanceWindow: &FalconMaintenanceWindow{Schedule: "every night"}}}}, "spec.node.updatePolicy.maintenanceWindow.schedule"},
+		{"negative maintenance window", FalconNodeSensorSpec{FalconAPI: api, Node: FalconNodeSensorConfig{UpdatePolicy: &FalconNodeUpdatePolicy{MaintenanceWindow: &FalconMaintenanceWindow{Schedule: "0 2 * * *", Duration: &metav1.Duration{Duration: -1}}}}}, "spec.node.updatePolicy.maintenanceWindow.duration"},
+		{"duplicate architectures", FalconNodeSensorSpec{FalconAPI: api, Node: FalconNodeSensorConfig{Architectures: []NodeArchitecture{NodeArchitectureAMD64, NodeArchitectureAMD64}}}, "spec.node.architectures[1]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodesensor := &FalconNodeSensor{ObjectMeta: metav1.ObjectMeta{Name: "falcon-node-sensor"}, Spec: tt.spec}
+			err := nodesensor.ValidateCreate()
+			if tt.wantField == "" {
+				if err != nil {
+					t.Errorf("ValidateCreate() unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantField+":") {
+				t.Errorf("ValidateCreate() error = %v, want an error for %s", err, tt.wantField)
+			}
+		})
+	}
+}
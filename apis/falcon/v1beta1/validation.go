@@ -0,0 +1,186 @@
+package v1beta1
+
+import (
+	"regexp"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/crowdstrike/falcon-operator/pkg/cron"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var (
+	cidPattern    = regexp.MustCompile("^[0-9a-fA-F]{32}-[0-9a-fA-F]{2}$")
+	ptokenPattern = regexp.MustCompile("^[0-9a-fA-F]{8}$")
+)
+
+// validateFalconSensor validates the sensor settings shared by the Falcon resources
+func validateFalconSensor(sensor *FalconSensor, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if sensor.CID != nil {
+		allErrs = append(allErrs, validateCID(*sensor.CID, path.Child("cid"))...)
+	}
+	if sensor.APP != nil {
+		if *sensor.APP < 0 || *sensor.APP > 65535 {
+			allErrs = append(allErrs, field.Invalid(path.Child("app"), *sensor.APP, "must be a port number between 0 and 65535"))
+		}
+		if sensor.APH == "" {
+			allErrs = append(allErrs, field.Required(path.Child("aph"), "the proxy host is required when the proxy port is set"))
+		}
+	}
+	if sensor.ProvisioningToken != "" && !ptokenPattern.MatchString(sensor.ProvisioningToken) {
+		allErrs = append(allErrs, field.Invalid(path.Child("provisioningToken"), sensor.ProvisioningToken, "must be 8 hexadecimal characters"))
+	}
+
+	seen := map[FalconSensorFeature]bool{}
+	for i, feature := range sensor.Features {
+		if seen[feature] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("features").Index(i), feature))
+		}
+		seen[feature] = true
+	}
+	if seen[FalconSensorFeatureNone] && len(sensor.Features) > 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("features"), sensor.Features, "none clears the features and cannot be combined with other features"))
+	}
+
+	return allErrs
+}
+
+// validateFalconAPI validates the Falcon API credentials
+func validateFalconAPI(api *FalconAPI, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if api.SecretRef != nil {
+		if api.SecretRef.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("secretRef", "name"), "the name of the Secret holding the API credentials is required"))
+		}
+		if api.SecretRef.Namespace == "" {
+			allErrs = append(allErrs, field.Required(path.Child("secretRef", "namespace"), "the namespace of the Secret holding the API credentials is required"))
+		}
+	} else {
+		if api.ClientID == "" {
+			allErrs = append(allErrs, field.Required(path.Child("clientID"), "clientID and clientSecret are required unless secretRef is set"))
+		}
+		if api.ClientSecret == "" {
+			allErrs = append(allErrs, field.Required(path.Child("clientSecret"), "clientID and clientSecret are required unless secretRef is set"))
+		}
+	}
+	if api.CID != nil {
+		allErrs = append(allErrs, validateCID(*api.CID, path.Child("cid"))...)
+	}
+
+	return allErrs
+}
+
+// validateRegistry validates that the registry type comes with the settings it requires
+func validateRegistry(registry *RegistrySpec, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch registry.Type {
+	case RegistryTypeACR:
+		if registry.ACRName == nil || *registry.ACRName == "" {
+			allErrs = append(allErrs, field.Required(path.Child("acrName"), "the name of the Azure Container Registry is required for the acr registry type"))
+		}
+	case RegistryTypeGeneric:
+		if registry.URI == "" {
+			allErrs = append(allErrs, field.Required(path.Child("uri"), "the repository to push to is required for the generic registry type"))
+		} else if named, err := reference.ParseNormalizedNamed(registry.URI); err != nil || !reference.IsNameOnly(named) {
+			allErrs = append(allErrs, field.Invalid(path.Child("uri"), registry.URI, "must be a repository without tag or digest, for instance harbor.example.com/falcon/falcon-sensor"))
+		}
+		if registry.PushSecretRef == nil || registry.PushSecretRef.Name == "" {
+			allErrs = append(allErrs, field.Required(path.Child("pushSecretRef", "name"), "a Secret with push credentials is required for the generic registry type"))
+		}
+	}
+
+	if registry.Type != RegistryTypeGeneric {
+		if registry.URI != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("uri"), "only applicable to the generic registry type"))
+		}
+		if registry.PushSecretRef != nil {
+			allErrs = append(allErrs, field.Forbidden(path.Child("pushSecretRef"), "only applicable to the generic registry type"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateCIDSource validates that the CID is either given or read from the Falcon API
+func validateCIDSource(api *FalconAPI, sensor *FalconSensor, apiPath, cidPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if api == nil && sensor.CID == nil {
+		allErrs = append(allErrs, field.Required(cidPath, "either falconAPI or falcon.cid is required"))
+	}
+	if api != nil {
+		allErrs = append(allErrs, validateFalconAPI(api, apiPath)...)
+	}
+
+	return allErrs
+}
+
+// validateNodeSensorConfig validates the DaemonSet settings of the Falcon Sensor
+func validateNodeSensorConfig(node *FalconNodeSensorConfig, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if policy := node.UpdatePolicy; policy != nil {
+		if node.Image != "" {
+			allErrs = append(allErrs, field.Forbidden(path.Child("updatePolicy"), "the update policy only applies to images from the CrowdStrike registry and cannot be combined with node.image"))
+		}
+		if policy.MaintenanceWindow != nil {
+			allErrs = append(allErrs, validateMaintenanceWindow(policy.MaintenanceWindow, path.Child("updatePolicy", "maintenanceWindow"))...)
+		}
+	}
+
+	seen := map[NodeArchitecture]bool{}
+	for i, arch := range node.Architectures {
+		if seen[arch] {
+			allErrs = append(allErrs, field.Duplicate(path.Child("architectures").Index(i), arch))
+		}
+		seen[arch] = true
+	}
+
+	return allErrs
+}
+
+// validateInjector validates the settings of the Falcon Container Injector
+func validateInjector(injector *FalconContainerInjectorSpec, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	tls := injector.TLS
+	if tls.Validity != nil && *tls.Validity <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("tls", "validity"), *tls.Validity, "must be a positive number of days"))
+	}
+	if tls.CertManager != nil && tls.CertManager.IssuerRef != nil && tls.CertManager.IssuerRef.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("tls", "certManager", "issuerRef", "name"), "the name of the issuer is required"))
+	}
+
+	return allErrs
+}
+
+func validateRefreshInterval(interval *metav1.Duration, path *field.Path) field.ErrorList {
+	if interval.Duration < MinRefreshInterval {
+		return field.ErrorList{field.Invalid(path, interval.Duration.String(), "must be at least "+MinRefreshInterval.String())}
+	}
+	return nil
+}
+
+func validateCID(cid string, path *field.Path) field.ErrorList {
+	if !cidPattern.MatchString(cid) {
+		return field.ErrorList{field.Invalid(path, cid, "must be a Falcon Customer ID of 32 hexadecimal characters followed by a dash and a 2 character checksum")}
+	}
+	return nil
+}
+
+func validateMaintenanceWindow(window *FalconMaintenanceWindow, path *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if _, err := cron.Parse(window.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(path.Child("schedule"), window.Schedule, err.Error()))
+	}
+	if window.Duration != nil && window.Duration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(path.Child("duration"), window.Duration.Duration.String(), "must be positive"))
+	}
+
+	return allErrs
+}
@@ -0,0 +1,246 @@
+package falcon
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/tls"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+const (
+	certManagerGroup              = "cert-manager.io"
+	certManagerVersion            = "v1"
+	certManagerIssuerName         = "falcon-sidecar-injector-selfsigned"
+	certManagerCertName           = "falcon-sidecar-injector"
+	certManagerInjectCAAnnotation = "cert-manager.io/inject-ca-from"
+	// certManagerCertificateCondition is recorded by Create and Delete for the cert-manager Certificate
+	certManagerCertificateCondition = "CertificateReady"
+)
+
+var (
+	certManagerIssuerGVK      = schema.GroupVersionKind{Group: certManagerGroup, Version: certManagerVersion, Kind: "Issuer"}
+	certManagerCertificateGVK = schema.GroupVersionKind{Group: certManagerGroup, Version: certManagerVersion, Kind: "Certificate"}
+)
+
+// certManagerEnabled reports whether the injector TLS certificate is issued by cert-manager
+func certManagerEnabled(falconContainer *v1beta1.FalconContainer) bool {
+	return falconContainer.Spec.Injector.TLS.CertManager != nil
+}
+
+// reconcileCertManagerTLS has cert-manager issue the injector TLS certificate. It returns nil until the
+// issued Secret is available.
+func (r *FalconContainerReconciler) reconcileCertManagerTLS(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.Secret, error) {
+	issuerRef := falconContainer.Spec.Injector.TLS.CertManager.IssuerRef
+	if issuerRef == nil {
+		if err := r.reconcileCertManagerObject(ctx, log, falconContainer, r.newCertManagerIssuer(falconContainer)); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := r.reconcileCertManagerObject(ctx, log, falconContainer, r.newCertManagerCertificate(falconContainer)); err != nil {
+		return nil, err
+	}
+
+	injectorTLSSecret := &corev1.Secret{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: injectorTLSSecretName, Namespace: falconContainer.TargetNs()}, injectorTLSSecret)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("unable to query existing injector TLS secret %s: %v", injectorTLSSecretName, err)
+	}
+
+	if len(injectorTLSSecret.Data["tls.crt"]) == 0 || len(injectorTLSSecret.Data["tls.key"]) == 0 || len(injectorTLSSecret.Data["ca.crt"]) == 0 {
+		return nil, nil
+	}
+
+	return injectorTLSSecret, nil
+}
+
+// reconcileCertManagerObject creates the cert-manager object or updates its spec when it drifted
+func (r *FalconContainerReconciler) reconcileCertManagerObject(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, obj *unstructured.Unstructured) error {
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(obj.GroupVersionKind())
+
+	err := r.Client.Get(ctx, types.NamespacedName{Name: obj.GetName(), Namespace: obj.GetNamespace()}, existing)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return fmt.Errorf("cert-manager %s is not available on the cluster; please install cert-manager: %v", obj.GetKind(), err)
+		}
+		if errors.IsNotFound(err) {
+			if err = ctrl.SetControllerReference(falconContainer, obj, r.Scheme); err != nil {
+				return fmt.Errorf("unable to set controller reference on cert-manager %s %s: %v", obj.GetKind(), obj.GetName(), err)
+			}
+			return r.Create(ctx, log, falconContainer, obj)
+		}
+		return fmt.Errorf("unable to query existing cert-manager %s %s: %v", obj.GetKind(), obj.GetName(), err)
+	}
+
+	// Only compare the fields the operator sets, so that values defaulted by cert-manager do not cause updates
+	spec, _ := obj.Object["spec"].(map[string]interface{})
+	existingSpec, ok := existing.Object["spec"].(map[string]interface{})
+	if !ok {
+		existingSpec = map[string]interface{}{}
+	}
+
+	if !mergeUnstructuredFields(existingSpec, spec) {
+		return nil
+	}
+
+	existing.Object["spec"] = existingSpec
+	return r.Update(ctx, log, falconContainer, existing)
+}
+
+// deleteCertManagerObjects removes the cert-manager objects left behind when cert-manager mode is turned off
+func (r *FalconContainerReconciler) deleteCertManagerObjects(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) error {
+	for _, gvk := range []schema.GroupVersionKind{certManagerCertificateGVK, certManagerIssuerGVK} {
+		name := certManagerCertName
+		if gvk == certManagerIssuerGVK {
+			name = certManagerIssuerName
+		}
+
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(gvk)
+		err := r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: falconContainer.TargetNs()}, existing)
+		if err != nil {
+			// cert-manager is not installed, so there is nothing to clean up
+			if meta.IsNoMatchError(err) || errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("unable to query existing cert-manager %s %s: %v", gvk.Kind, name, err)
+		}
+
+		if !metav1.IsControlledBy(existing, falconContainer) {
+			continue
+		}
+
+		if err := r.Delete(ctx, log, falconContainer, existing); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *FalconContainerReconciler) newCertManagerIssuer(falconContainer *v1beta1.FalconContainer) *unstructured.Unstructured {
+	issuer := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": map[string]interface{}{
+				"selfSigned": map[string]interface{}{},
+			},
+		},
+	}
+	issuer.SetGroupVersionKind(certManagerIssuerGVK)
+	issuer.SetName(certManagerIssuerName)
+	issuer.SetNamespace(falconContainer.TargetNs())
+	issuer.SetLabels(FcLabels)
+	return issuer
+}
+
+func (r *FalconContainerReconciler) newCertManagerCertificate(falconContainer *v1beta1.FalconContainer) *unstructured.Unstructured {
+	serviceName := fmt.Sprintf("%s.%s.svc", injectorName, falconContainer.TargetNs())
+
+	issuerRef := map[string]interface{}{
+		"name":  certManagerIssuerName,
+		"kind":  certManagerIssuerGVK.Kind,
+		"group": certManagerGroup,
+	}
+	if ref := falconContainer.Spec.Injector.TLS.CertManager.IssuerRef; ref != nil {
+		issuerRef["name"] = ref.Name
+		if ref.Kind != "" {
+			issuerRef["kind"] = ref.Kind
+		}
+		if ref.Group != "" {
+			issuerRef["group"] = ref.Group
+		}
+	}
+
+	validity := falconContainer.InjectorTLSValidity()
+	renewBefore := falconContainer.InjectorTLSRenewBefore()
+	// cert-manager rejects a renewal window that is not shorter than the certificate lifetime
+	if renewBefore*2 > validity {
+		renewBefore = validity / 2
+	}
+
+	privateKey := map[string]interface{}{
+		"algorithm": "RSA",
+		"size":      int64(2048),
+	}
+	switch injectorKeyAlgorithm(falconContainer) {
+	case tls.RSA3072:
+		privateKey["size"] = int64(3072)
+	case tls.RSA4096:
+		privateKey["size"] = int64(4096)
+	case tls.ECDSAP256:
+		privateKey["algorithm"] = "ECDSA"
+		privateKey["size"] = int64(256)
+	case tls.ECDSAP384:
+		privateKey["algorithm"] = "ECDSA"
+		privateKey["size"] = int64(384)
+	}
+
+	spec := map[string]interface{}{
+		"secretName":  injectorTLSSecretName,
+		"commonName":  serviceName,
+		"dnsNames":    []interface{}{serviceName, serviceName + ".cluster.local"},
+		"duration":    (time.Duration(validity) * 24 * time.Hour).String(),
+		"renewBefore": (time.Duration(renewBefore) * 24 * time.Hour).String(),
+		"usages":      []interface{}{"server auth", "client auth", "digital signature", "key encipherment"},
+		"issuerRef":   issuerRef,
+		"privateKey":  privateKey,
+		"secretTemplate": map[string]interface{}{
+			"labels": stringMapToInterface(FcLabels),
+		},
+	}
+
+	certificate := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"spec": spec,
+		},
+	}
+	certificate.SetGroupVersionKind(certManagerCertificateGVK)
+	certificate.SetName(certManagerCertName)
+	certificate.SetNamespace(falconContainer.TargetNs())
+	certificate.SetLabels(FcLabels)
+	return certificate
+}
+
+// mergeUnstructuredFields copies the fields set in src into dst, descending into nested objects so that fields
+// only present in dst are kept. It reports whether dst changed.
+func mergeUnstructuredFields(dst, src map[string]interface{}) bool {
+	updated := false
+	for key, value := range src {
+		nested, ok := value.(map[string]interface{})
+		existingNested, existingOk := dst[key].(map[string]interface{})
+		if ok && existingOk {
+			updated = mergeUnstructuredFields(existingNested, nested) || updated
+			continue
+		}
+		if !reflect.DeepEqual(value, dst[key]) {
+			dst[key] = value
+			updated = true
+		}
+	}
+	return updated
+}
+
+// stringMapToInterface converts labels into a form that can be stored in an unstructured object
+func stringMapToInterface(in map[string]string) map[string]interface{} {
+	out := make(map[string]interface{}, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package falcon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReconcileCertManagerObject(t *testing.T) {
+	ctx := context.Background()
+	log := logr.Discard()
+	falconContainer := &v1beta1.FalconContainer{ObjectMeta: metav1.ObjectMeta{Name: "falcon-sidecar-sensor"}}
+	falconContainer.Spec.Injector.TLS.CertManager = &v1beta1.FalconContainerCertManager{}
+	r := newTestReconciler(t, falconContainer)
+
+	getCertificate := func() *unstructured.Unstructured {
+		t.Helper()
+		certificate := &unstructured.Unstructured{}
+		certificate.SetGroupVersionKind(certManagerCertificateGVK)
+		if err := r.Get(ctx, client.ObjectKeyFromObject(r.newCertManagerCertificate(falconContainer)), certificate); err != nil {
+			t.Fatal(err)
+		}
+		return certificate
+	}
+
+	// A missing Certificate is created and owned by the FalconContainer
+	if err := r.reconcileCertManagerObject(ctx, log, falconContainer, r.newCertManagerCertificate(falconContainer)); err != nil {
+		t.Fatalf("reconcileCertManagerObject() error = %v", err)
+	}
+	certificate := getCertificate()
+	if !metav1.IsControlledBy(certificate, falconContainer) {
+		t.Error("created Certificate is not controlled by the FalconContainer")
+	}
+
+	// Fields defaulted by cert-manager are not drift
+	if err := unstructured.SetNestedField(certificate.Object, "Always", "spec", "privateKey", "rotationPolicy"); err != nil {
+		t.Fatal(err)
+	}
+	if err := unstructured.SetNestedField(certificate.Object, int64(1), "spec", "revisionHistoryLimit"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Client.Update(ctx, certificate); err != nil {
+		t.Fatal(err)
+	}
+	resourceVersion := getCertificate().GetResourceVersion()
+	if err := r.reconcileCertManagerObject(ctx, log, falconContainer, r.newCertManagerCertificate(falconContainer)); err != nil {
+		t.Fatalf("reconcileCertManagerObject() error = %v", err)
+	}
+	if got := getCertificate().GetResourceVersion(); got != resourceVersion {
+		t.Errorf("Certificate updated without drift, resourceVersion %s -> %s", resourceVersion, got)
+	}
+
+	// A changed field is restored while the defaulted fields are kept
+	certificate = getCertificate()
+	if err := unstructured.SetNestedField(certificate.Object, "1h0m0s", "spec", "duration"); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Client.Update(ctx, certificate); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.reconcileCertManagerObject(ctx, log, falconContainer, r.newCertManagerCertificate(falconContainer)); err != nil {
+		t.Fatalf("reconcileCertManagerObject() error = %v", err)
+	}
+	certificate = getCertificate()
+	want, _, _ := unstructured.NestedString(r.newCertManagerCertificate(falconContainer).Object, "spec", "duration")
+	if got, _, _ := unstructured.NestedString(certificate.Object, "spec", "duration"); got != want {
+		t.Errorf("Certificate duration = %s, want %s", got, want)
+	}
+	if got, found, _ := unstructured.NestedInt64(certificate.Object, "spec", "revisionHistoryLimit"); !found || got != 1 {
+		t.Error("Certificate revisionHistoryLimit defaulted by cert-manager was dropped")
+	}
+	if got, _, _ := unstructured.NestedString(certificate.Object, "spec", "privateKey", "rotationPolicy"); got != "Always" {
+		t.Error("Certificate privateKey rotationPolicy defaulted by cert-manager was dropped")
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/crowdstrike/falcon-operator/pkg/falcon_api"
 	"github.com/go-logr/logr"
@@ -18,21 +18,21 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-func (r *FalconContainerReconciler) reconcileRegistryCABundleConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.ConfigMap, error) {
+func (r *FalconContainerReconciler) reconcileRegistryCABundleConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.ConfigMap, error) {
 	return r.reconcileGenericConfigMap(registryCABundleConfigMapName, r.newCABundleConfigMap, ctx, log, falconContainer)
 }
 
-func (r *FalconContainerReconciler) reconcileConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.ConfigMap, error) {
+func (r *FalconContainerReconciler) reconcileConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.ConfigMap, error) {
 	return r.reconcileGenericConfigMap(injectorConfigMapName, r.newConfigMap, ctx, log, falconContainer)
 }
 
-func (r *FalconContainerReconciler) reconcileGenericConfigMap(name string, genFunc func(context.Context, logr.Logger, *v1alpha1.FalconContainer) (*corev1.ConfigMap, error), ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.ConfigMap, error) {
+func (r *FalconContainerReconciler) reconcileGenericConfigMap(name string, genFunc func(context.Context, logr.Logger, *v1beta1.FalconContainer) (*corev1.ConfigMap, error), ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.ConfigMap, error) {
 	configMap, err := genFunc(ctx, log, falconContainer)
 	if err != nil {
 		return configMap, fmt.Errorf("unable to render expected configmap: %v", err)
 	}
 	existingConfigMap := &corev1.ConfigMap{}
-	err = r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: r.Namespace()}, existingConfigMap)
+	err = r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: falconContainer.TargetNs()}, existingConfigMap)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			if err = ctrl.SetControllerReference(falconContainer, configMap, r.Scheme); err != nil {
@@ -50,7 +50,7 @@ func (r *FalconContainerReconciler) reconcileGenericConfigMap(name string, genFu
 
 }
 
-func (r *FalconContainerReconciler) newCABundleConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.ConfigMap, error) {
+func (r *FalconContainerReconciler) newCABundleConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.ConfigMap, error) {
 	data := make(map[string]string)
 	if falconContainer.Spec.Registry.TLS.CACertificate != "" {
 		data["tls.crt"] = string(common.DecodeBase64Interface(falconContainer.Spec.Registry.TLS.CACertificate))
@@ -61,7 +61,7 @@ func (r *FalconContainerReconciler) newCABundleConfigMap(ctx context.Context, lo
 			},
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      registryCABundleConfigMapName,
-				Namespace: r.Namespace(),
+				Namespace: falconContainer.TargetNs(),
 				Labels:    FcLabels,
 			},
 			Data: data,
@@ -70,10 +70,10 @@ func (r *FalconContainerReconciler) newCABundleConfigMap(ctx context.Context, lo
 	return &corev1.ConfigMap{}, fmt.Errorf("unable to determine contents of Registry TLS CACertificate attribute")
 }
 
-func (r *FalconContainerReconciler) newConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.ConfigMap, error) {
+func (r *FalconContainerReconciler) newConfigMap(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.ConfigMap, error) {
 	data := common.MakeSensorEnvMap(falconContainer.Spec.Falcon)
-	data["CP_NAMESPACE"] = r.Namespace()
-	data["FALCON_INJECTOR_LISTEN_PORT"] = strconv.Itoa(int(*falconContainer.Spec.Injector.ListenPort))
+	data["CP_NAMESPACE"] = falconContainer.TargetNs()
+	data["FALCON_INJECTOR_LISTEN_PORT"] = strconv.Itoa(int(falconContainer.InjectorListenPort()))
 
 	imageUri, err := r.imageUri(ctx, falconContainer)
 	if err != nil {
@@ -96,7 +96,12 @@ func (r *FalconContainerReconciler) newConfigMap(ctx context.Context, log logr.L
 	}
 
 	if cid == "" && falconContainer.Spec.FalconAPI != nil {
-		cid, err = falcon_api.FalconCID(ctx, falconContainer.Spec.FalconAPI.CID, falconContainer.Spec.FalconAPI.ApiConfig())
+		apiConfig, err := r.falconApiConfig(ctx, falconContainer)
+		if err != nil {
+			return &corev1.ConfigMap{}, fmt.Errorf("unable to determine Falcon customer ID (CID): %v", err)
+		}
+
+		cid, err = falcon_api.FalconCID(ctx, falconContainer.Spec.FalconAPI.CID, apiConfig)
 		if err != nil {
 			return &corev1.ConfigMap{}, fmt.Errorf("unable to determine Falcon customer ID (CID): %v", err)
 		}
@@ -134,7 +139,7 @@ func (r *FalconContainerReconciler) newConfigMap(ctx context.Context, log logr.L
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      injectorConfigMapName,
-			Namespace: r.Namespace(),
+			Namespace: falconContainer.TargetNs(),
 			Labels:    FcLabels,
 		},
 		Data: data,
@@ -5,19 +5,59 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/aws"
 )
 
-func (r *FalconContainerReconciler) UpsertECRRepo(ctx context.Context) (*types.Repository, error) {
+const (
+	ecrRepoName = "falcon-container"
+)
+
+// UpsertECRRepo returns the ECR repository the Falcon Container image is mirrored to. A repository created by the operator is
+// recorded in the FalconContainer status, so that it can be deleted with the FalconContainer.
+func (r *FalconContainerReconciler) UpsertECRRepo(ctx context.Context, falconContainer *v1beta1.FalconContainer) (*types.Repository, error) {
 	cfg, err := aws.NewConfig()
 	if err != nil {
 		return nil, fmt.Errorf("Failed to initialise connection to AWS. Please make sure that kubernetes service account falcon-operator has access to AWS IAM role and OIDC Identity provider is running on the cluster. Error was: %v", err)
 	}
 
-	data, err := cfg.UpsertRepository(ctx, "falcon-container")
+	data, created, err := cfg.UpsertRepository(ctx, ecrRepoName)
 	if err != nil {
 		return nil, fmt.Errorf("Failed to upsert ECR repository: %v", err)
 	}
 
+	if created {
+		falconContainer.Status.CreatedECRRepository = ecrRepoName
+		if err := r.Status().Update(ctx, falconContainer); err != nil {
+			return nil, fmt.Errorf("Failed to record the created ECR repository %s in the FalconContainer status: %v", ecrRepoName, err)
+		}
+	}
+
 	return data, nil
 }
+
+// DeleteECRRepo deletes the ECR repository recorded as created by the operator, together with the images the operator pushed
+// to it. It returns a *finalizeWarning when the operator may not delete the repository.
+func (r *FalconContainerReconciler) DeleteECRRepo(ctx context.Context, falconContainer *v1beta1.FalconContainer) error {
+	name := falconContainer.Status.CreatedECRRepository
+	if name == "" {
+		return nil
+	}
+
+	cfg, err := aws.NewConfig()
+	if err != nil {
+		return fmt.Errorf("Failed to initialise connection to AWS. Please make sure that kubernetes service account falcon-operator has access to AWS IAM role and OIDC Identity provider is running on the cluster. Error was: %v", err)
+	}
+
+	if err := cfg.DeleteRepository(ctx, name, true); err != nil {
+		if aws.IsRepositoryRetained(err) {
+			return &finalizeWarning{
+				reason:  v1beta1.ReasonImageArtifactsKept,
+				message: fmt.Sprintf("Kept ECR repository %s, delete it manually once no other cluster uses it: %v", name, err),
+			}
+		}
+		return fmt.Errorf("Failed to delete ECR repository: %v", err)
+	}
+
+	return nil
+}
@@ -6,7 +6,8 @@ import (
 	"os"
 	"time"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/crowdstrike/falcon-operator/version"
 	"github.com/go-logr/logr"
 	arv1 "k8s.io/api/admissionregistration/v1"
@@ -17,10 +18,16 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
 // FalconContainerReconciler reconciles a FalconContainer object
@@ -29,12 +36,15 @@ type FalconContainerReconciler struct {
 	Log        logr.Logger
 	Scheme     *runtime.Scheme
 	RestConfig *rest.Config
+	Recorder   record.EventRecorder
+
+	pullTokens pullTokenCache
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *FalconContainerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&v1alpha1.FalconContainer{}).
+		For(&v1beta1.FalconContainer{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&corev1.Namespace{}).
 		Owns(&corev1.Secret{}).
@@ -43,9 +53,84 @@ func (r *FalconContainerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.ServiceAccount{}).
 		Owns(&rbacv1.ClusterRoleBinding{}).
 		Owns(&arv1.MutatingWebhookConfiguration{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.falconAPISecretRequests)).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.injectorTLSSecretRequests)).
+		Watches(&source.Kind{Type: &corev1.Namespace{}}, handler.EnqueueRequestsFromMapFunc(r.namespaceRequests)).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.pullSecretRequests)).
 		Complete(r)
 }
 
+// pullSecretRequests maps a registry pull token Secret to a namespace request of its FalconContainer, so that a removed or
+// modified pull token is restored without sweeping all namespaces
+func (r *FalconContainerReconciler) pullSecretRequests(obj client.Object) []reconcile.Request {
+	if obj.GetName() != common.FalconPullSecretName {
+		return nil
+	}
+
+	owner := metav1.GetControllerOf(obj)
+	if owner == nil || owner.Kind != "FalconContainer" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: owner.Name, Namespace: obj.GetNamespace()}}}
+}
+
+// injectorTLSSecretRequests maps the injector TLS Secret to its FalconContainer. The Secret is not owned by the
+// FalconContainer when it is issued by cert-manager.
+func (r *FalconContainerReconciler) injectorTLSSecretRequests(obj client.Object) []reconcile.Request {
+	if obj.GetName() != injectorTLSSecretName {
+		return nil
+	}
+
+	falconContainers := &v1beta1.FalconContainerList{}
+	if err := r.List(context.Background(), falconContainers); err != nil {
+		log.Log.Error(err, "Failed to list FalconContainers for Secret change", "Secret.Namespace", obj.GetNamespace(), "Secret.Name", obj.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, falconContainer := range falconContainers.Items {
+		if certManagerEnabled(&falconContainer) && falconContainer.TargetNs() == obj.GetNamespace() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: falconContainer.Name}})
+		}
+	}
+	return requests
+}
+
+// namespaceRequests maps a Namespace to the FalconContainers distributing registry pull tokens. The namespace is
+// carried in the request so that only the changed namespace is reconciled; FalconContainers are cluster scoped.
+func (r *FalconContainerReconciler) namespaceRequests(obj client.Object) []reconcile.Request {
+	falconContainers := &v1beta1.FalconContainerList{}
+	if err := r.List(context.Background(), falconContainers); err != nil {
+		log.Log.Error(err, "Failed to list FalconContainers for Namespace change", "Namespace.Name", obj.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, falconContainer := range falconContainers.Items {
+		if r.registryPullSecretsEnabled(&falconContainer) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: falconContainer.Name, Namespace: obj.GetName()}})
+		}
+	}
+	return requests
+}
+
+// falconAPISecretRequests maps a Secret to the FalconContainers reading their Falcon API credentials from it
+func (r *FalconContainerReconciler) falconAPISecretRequests(obj client.Object) []reconcile.Request {
+	falconContainers := &v1beta1.FalconContainerList{}
+	if err := r.List(context.Background(), falconContainers); err != nil {
+		log.Log.Error(err, "Failed to list FalconContainers for Secret change", "Secret.Namespace", obj.GetNamespace(), "Secret.Name", obj.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, falconContainer := range falconContainers.Items {
+		if falconContainer.Spec.FalconAPI != nil && falconContainer.Spec.FalconAPI.ReferencesSecret(obj.GetNamespace(), obj.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: falconContainer.Name}})
+		}
+	}
+	return requests
+}
+
 //+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falconcontainers,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falconcontainers/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falconcontainers/finalizers,verbs=get;update;patch
@@ -57,9 +142,12 @@ func (r *FalconContainerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:rbac:groups="",resources=deployments,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups="",resources=services,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups="",resources=endpoints,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups="apps",resources=deployments,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups="admissionregistration.k8s.io",resources=mutatingwebhookconfigurations,verbs=get;list;watch;create;update;delete
+// +kubebuilder:rbac:groups="cert-manager.io",resources=issuers;certificates,verbs=get;list;watch;create;update;delete
 // +kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=clusterrolebindings,verbs=get;list;watch;create;update;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -69,7 +157,15 @@ func (r *FalconContainerReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.7.2/pkg/reconcile
 func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := log.FromContext(ctx)
-	falconContainer := &v1alpha1.FalconContainer{}
+	falconContainer := &v1beta1.FalconContainer{}
+
+	// Namespace events only propagate the registry pull token to the namespace that changed
+	if req.Namespace != "" {
+		return r.reconcileNamespaceEvent(ctx, req, log)
+	}
+
+	// The Falcon Container image digest is looked up once per reconcile
+	ctx = withImageDigests(ctx)
 
 	if err := r.Get(ctx, req.NamespacedName, falconContainer); err != nil {
 		if errors.IsNotFound(err) {
@@ -82,10 +178,38 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, err
 	}
 
+	// Check if the FalconContainer instance is marked to be deleted, which is
+	// indicated by the deletion timestamp being set.
+	if falconContainer.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(falconContainer, common.FalconFinalizer) {
+			// Run finalization logic for common.FalconFinalizer. If the
+			// finalization logic fails, don't remove the finalizer so
+			// that we can retry during the next reconciliation.
+			if err := r.finalizeFalconContainer(ctx, req, log, falconContainer); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			// Remove common.FalconFinalizer. Once all finalizers have been
+			// removed, the object will be deleted.
+			patch := client.MergeFrom(falconContainer.DeepCopy())
+			controllerutil.RemoveFinalizer(falconContainer, common.FalconFinalizer)
+			if err := r.Client.Patch(ctx, falconContainer, patch); err != nil {
+				return ctrl.Result{}, err
+			}
+			log.Info("Removing finalizer")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// The defaulting webhook owns the spec. FalconContainers created before it was installed, or served with the webhooks
+	// disabled, are only defaulted in memory, so the spec is never written back. Every write decodes the stored object
+	// into falconContainer again, so the injector settings are read through the nil-safe FalconContainer accessors.
+	falconContainer.Default()
+
 	if falconContainer.Status.Conditions == nil || len(falconContainer.Status.Conditions) == 0 {
-		err := r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionPending,
+		err := r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionPending,
 			metav1.ConditionFalse,
-			v1alpha1.ReasonReqNotMet,
+			v1beta1.ReasonReqNotMet,
 			"FalconContainer progressing")
 		if err != nil {
 			return ctrl.Result{}, err
@@ -101,10 +225,25 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 
+	// Add finalizer for this CR
+	if !controllerutil.ContainsFinalizer(falconContainer, common.FalconFinalizer) {
+		patch := client.MergeFrom(falconContainer.DeepCopy())
+		controllerutil.AddFinalizer(falconContainer, common.FalconFinalizer)
+		if err := r.Client.Patch(ctx, falconContainer, patch); err != nil {
+			log.Error(err, "Unable to update finalizer")
+			return ctrl.Result{}, err
+		}
+		log.Info("Adding finalizer")
+	}
+
 	if _, err := r.reconcileNamespace(ctx, log, falconContainer); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile namespace: %v", err)
 	}
 
+	// A due image refresh looks up the newest matching image again and mirrors it
+	previousImage := currentSensorImage(falconContainer)
+	refreshImage := imageRefreshDue(falconContainer, time.Now())
+
 	// Image being set will override other image based settings
 	if falconContainer.Spec.Image != nil && *falconContainer.Spec.Image != "" {
 		if _, err := r.setImageTag(ctx, falconContainer); err != nil {
@@ -116,18 +255,18 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	} else {
 		switch falconContainer.Spec.Registry.Type {
-		case v1alpha1.RegistryTypeECR:
-			if _, err := r.UpsertECRRepo(ctx); err != nil {
-				err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile ECR repository: %v", err))
+		case v1beta1.RegistryTypeECR:
+			if _, err := r.UpsertECRRepo(ctx, falconContainer); err != nil {
+				err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile ECR repository: %v", err))
 				if err != nil {
 					return ctrl.Result{}, err
 				}
 				return ctrl.Result{}, fmt.Errorf("failed to reconcile ECR repository: %v", err)
 			}
-		case v1alpha1.RegistryTypeOpenshift:
+		case v1beta1.RegistryTypeOpenshift:
 			stream, err := r.reconcileImageStream(ctx, log, falconContainer)
 			if err != nil {
-				err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Image Stream: %v", err))
+				err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Image Stream: %v", err))
 				if err != nil {
 					return ctrl.Result{}, err
 				}
@@ -141,7 +280,7 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		// Create a CA Bundle ConfigMap if CACertificate attribute is set; overridden by the presence of a CACertificateConfigMap value
 		if falconContainer.Spec.Registry.TLS.CACertificateConfigMap == "" && falconContainer.Spec.Registry.TLS.CACertificate != "" {
 			if _, err := r.reconcileRegistryCABundleConfigMap(ctx, log, falconContainer); err != nil {
-				err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Registry CA Certificate Bundle ConfigMap: %v", err))
+				err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Registry CA Certificate Bundle ConfigMap: %v", err))
 				if err != nil {
 					return ctrl.Result{}, err
 				}
@@ -151,7 +290,7 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 		if r.imageMirroringEnabled(falconContainer) {
 			if err := r.PushImage(ctx, log, falconContainer); err != nil {
-				err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to refresh Falcon Container image: %v", err))
+				err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to refresh Falcon Container image: %v", err))
 				if err != nil {
 					return ctrl.Result{}, err
 				}
@@ -163,15 +302,15 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 				return ctrl.Result{}, nil
 			}
 			if err != nil {
-				err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to verify CrowdStrike Container Image Registry access: %v", err))
+				err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to verify CrowdStrike Container Image Registry access: %v", err))
 				if err != nil {
 					return ctrl.Result{}, err
 				}
 				return ctrl.Result{}, fmt.Errorf("failed to verify CrowdStrike Container Image Registry access")
 			}
 
-			if _, err = r.reconcileRegistrySecrets(ctx, log, falconContainer); err != nil {
-				err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Falcon registry pull token Secrets: %v", err))
+			if _, err = r.reconcileRegistrySecrets(ctx, req, log, falconContainer); err != nil {
+				err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Falcon registry pull token Secrets: %v", err))
 				if err != nil {
 					return ctrl.Result{}, err
 				}
@@ -181,7 +320,7 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	if _, err := r.reconcileServiceAccount(ctx, log, falconContainer); err != nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Service Account: %v", err))
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Service Account: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -189,7 +328,7 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	if _, err := r.reconcileClusterRoleBinding(ctx, log, falconContainer); err != nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Cluster Role Binding: %v", err))
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile Cluster Role Binding: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -198,15 +337,22 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 
 	injectorTLS, err := r.reconcileInjectorTLSSecret(ctx, log, falconContainer)
 	if err != nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector TLS Secret: %v", err))
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector TLS Secret: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile injector TLS Secret: %v", err)
 	}
-	caBundle := injectorTLS.Data["ca.crt"]
-	if caBundle == nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", "CA bundle not present in injector TLS Secret")
+	if injectorTLS == nil {
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionSecretReady, metav1.ConditionFalse, v1beta1.ReasonReqNotMet, "Waiting for cert-manager to issue the injector TLS Secret")
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		log.Info("Waiting for cert-manager to issue the injector TLS Secret", "namespace", falconContainer.TargetNs())
+		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
+	}
+	if injectorTLS.Data["ca.crt"] == nil {
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", "CA bundle not present in injector TLS Secret")
 		if err != nil {
 			return ctrl.Result{}, err
 		}
@@ -214,62 +360,132 @@ func (r *FalconContainerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	if _, err = r.reconcileConfigMap(ctx, log, falconContainer); err != nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector ConfigMap: %v", err))
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector ConfigMap: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile injector ConfigMap: %v", err)
 	}
 
-	if _, err = r.reconcileDeployment(ctx, log, falconContainer); err != nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector Deployment: %v", err))
+	deployment, err := r.reconcileDeployment(ctx, log, falconContainer, injectorTLS)
+	if err != nil {
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector Deployment: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile injector Deployment: %v", err)
 	}
 
-	if _, err = r.reconcileService(ctx, log, falconContainer); err != nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector Service: %v", err))
+	if err = r.completeImageRefresh(ctx, log, falconContainer, previousImage, refreshImage); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err = r.reconcileDeploymentStatus(ctx, req, log, falconContainer, deployment); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if err = r.completeInjectorTLSRotation(ctx, log, falconContainer, injectorTLS, deployment); err != nil {
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to complete injector TLS certificate rotation: %v", err))
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, fmt.Errorf("failed to complete injector TLS certificate rotation: %v", err)
+	}
+	caBundle := injectorCABundle(injectorTLS)
+
+	service, err := r.reconcileService(ctx, log, falconContainer)
+	if err != nil {
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector Service: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile injector Service: %v", err)
 	}
 
+	if err = r.reconcileServiceStatus(ctx, req, log, falconContainer, service); err != nil {
+		return ctrl.Result{}, err
+	}
+
 	pod, err := r.injectorPodReady(ctx, falconContainer)
 	if err != nil && err.Error() != "No Injector pod found in a Ready state" {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to find Ready injector pod: %v", err))
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to find Ready injector pod: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, fmt.Errorf("failed to find Ready injector pod: %v", err)
 	}
 	if pod.Name == "" {
-		log.Info("Looking for a Ready injector pod", "namespace", r.Namespace())
+		log.Info("Looking for a Ready injector pod", "namespace", falconContainer.TargetNs())
 		return ctrl.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
-	if _, err = r.reconcileWebhook(ctx, log, falconContainer, caBundle); err != nil {
-		err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector MutatingWebhookConfiguration: %v", err))
+	webhook, err := r.reconcileWebhook(ctx, log, falconContainer, caBundle)
+	if err != nil {
+		err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFailed, metav1.ConditionFalse, "Reconciling", fmt.Sprintf("failed to reconcile injector MutatingWebhookConfiguration: %v", err))
 		if err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{}, fmt.Errorf("failed to reconcile injector MutatingWebhookConfiguration: %v", err)
 	}
 
-	err = r.StatusUpdate(ctx, req, log, falconContainer, v1alpha1.ConditionSuccess,
+	if err = r.reconcileWebhookStatus(ctx, req, log, falconContainer, webhook, caBundle); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionSuccess,
 		metav1.ConditionTrue,
-		v1alpha1.ReasonInstallSucceeded,
+		v1beta1.ReasonInstallSucceeded,
 		"FalconContainer installation completed")
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
+	// Come back for the next image refresh
+	refreshAfter := imageRefreshRequeueAfter(falconContainer, time.Now())
+
+	// cert-manager renews the certificate; the injector TLS Secret watch picks up the change
+	if certManagerEnabled(falconContainer) {
+		return ctrl.Result{RequeueAfter: refreshAfter}, nil
+	}
+
+	// Come back in time to renew the injector TLS certificate
+	renewAt, err := injectorTLSRenewalTime(injectorTLS, falconContainer)
+	if err != nil {
+		return ctrl.Result{Requeue: true}, nil
+	}
+
+	if renewAfter := time.Until(renewAt); refreshAfter == 0 || renewAfter < refreshAfter {
+		return ctrl.Result{RequeueAfter: renewAfter}, nil
+	}
+	return ctrl.Result{RequeueAfter: refreshAfter}, nil
+}
+
+// reconcileNamespaceEvent handles a request produced by namespaceRequests; req.Name is the FalconContainer and
+// req.Namespace the namespace that changed
+func (r *FalconContainerReconciler) reconcileNamespaceEvent(ctx context.Context, req ctrl.Request, log logr.Logger) (ctrl.Result, error) {
+	falconContainer := &v1beta1.FalconContainer{}
+	if err := r.Get(ctx, types.NamespacedName{Name: req.Name}, falconContainer); err != nil {
+		if errors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Pull secrets are distributed to all namespaces by the first full reconcile once the finalizer is in place
+	if falconContainer.GetDeletionTimestamp() != nil || !controllerutil.ContainsFinalizer(falconContainer, common.FalconFinalizer) || !r.registryPullSecretsEnabled(falconContainer) {
+		return ctrl.Result{}, nil
+	}
+
+	// The FalconContainer is cluster scoped, the namespace of the request only names the namespace to update
+	fcReq := ctrl.Request{NamespacedName: types.NamespacedName{Name: req.Name}}
+	if err := r.reconcileNamespaceRegistrySecret(ctx, fcReq, log, falconContainer, req.Namespace); err != nil {
+		return ctrl.Result{}, fmt.Errorf("failed to reconcile Falcon registry pull token Secret: %v", err)
+	}
+
 	return ctrl.Result{}, nil
 }
 
-func (r *FalconContainerReconciler) StatusUpdate(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1alpha1.FalconContainer, condType string, status metav1.ConditionStatus, reason string, message string) error {
+func (r *FalconContainerReconciler) StatusUpdate(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer, condType string, status metav1.ConditionStatus, reason string, message string) error {
 	meta.SetStatusCondition(&falconContainer.Status.Conditions, metav1.Condition{
 		Status:             status,
 		Reason:             reason,
@@ -291,5 +507,6 @@ func (r *FalconContainerReconciler) StatusUpdate(ctx context.Context, req ctrl.R
 		log.Error(err, "Failed to re-fetch FalconContainer")
 		return err
 	}
+	falconContainer.Default()
 	return nil
 }
@@ -0,0 +1,154 @@
+package falcon
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"os"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/go-logr/logr"
+	imagev1 "github.com/openshift/api/image/v1"
+	arv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// finalizeWarning is returned by a finalization step that left something behind which must not block the removal of the
+// FalconContainer, for instance a shared registry repository the operator may not delete
+type finalizeWarning struct {
+	reason  string
+	message string
+}
+
+func (w *finalizeWarning) Error() string {
+	return w.message
+}
+
+// finalizeFalconContainer tears down everything the FalconContainer installed. The webhook goes first
+// so that pod admission is not blocked while the injector is removed, and the namespace goes last.
+// Progress is recorded in the Finalizing status condition; a failed step is retried on the next reconcile.
+func (r *FalconContainerReconciler) finalizeFalconContainer(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer) error {
+	steps := []struct {
+		reason  string
+		message string
+		run     func(context.Context, logr.Logger, *v1beta1.FalconContainer) error
+	}{
+		{v1beta1.ReasonWebhookRemoved, "Removed injector MutatingWebhookConfiguration", r.finalizeWebhook},
+		{v1beta1.ReasonPullSecretsRemoved, "Removed registry pull token Secrets", r.finalizePullSecrets},
+		{v1beta1.ReasonImageArtifactsRemoved, "Removed mirrored image artifacts", r.finalizeImageArtifacts},
+		{v1beta1.ReasonNamespaceRemoved, "Removed injector namespace", r.finalizeNamespace},
+	}
+
+	err := r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFinalizing, metav1.ConditionTrue, v1beta1.ReasonFinalizeStarted, "FalconContainer cleanup started")
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		if err := step.run(ctx, log, falconContainer); err != nil {
+			var warning *finalizeWarning
+			if stderrors.As(err, &warning) {
+				log.Info("Continuing cleanup without removing all artifacts", "reason", warning.reason, "message", warning.message)
+				r.Recorder.Event(falconContainer, corev1.EventTypeWarning, warning.reason, warning.message)
+				if err := r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFinalizing, metav1.ConditionTrue, warning.reason, warning.message); err != nil {
+					return err
+				}
+				continue
+			}
+
+			updateErr := r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFinalizing, metav1.ConditionFalse, v1beta1.ReasonFinalizeFailed, err.Error())
+			if updateErr != nil {
+				return updateErr
+			}
+			return err
+		}
+
+		if err := r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionFinalizing, metav1.ConditionTrue, step.reason, step.message); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *FalconContainerReconciler) finalizeWebhook(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) error {
+	webhook := &arv1.MutatingWebhookConfiguration{}
+	return r.deleteIfControlled(ctx, log, falconContainer, types.NamespacedName{Name: webhookName}, webhook)
+}
+
+func (r *FalconContainerReconciler) finalizePullSecrets(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) error {
+	secrets, err := r.listRegistrySecrets(ctx, falconContainer)
+	if err != nil {
+		return err
+	}
+
+	r.pullTokens.forget(falconContainer.Name)
+
+	for i := range secrets {
+		secret := &secrets[i]
+		log.Info("Deleting registry pull token secret", "namespace", secret.Namespace)
+		if err := r.Client.Delete(ctx, secret); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("unable to delete registry pull token secret in namespace %s: %v", secret.Namespace, err)
+		}
+	}
+
+	return nil
+}
+
+func (r *FalconContainerReconciler) finalizeImageArtifacts(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) error {
+	// Nothing was mirrored when the image is provided by the user
+	if falconContainer.Spec.Image != nil && *falconContainer.Spec.Image != "" {
+		return nil
+	}
+	if os.Getenv("RELATED_IMAGE_SIDECAR_SENSOR") != "" && falconContainer.Spec.FalconAPI == nil {
+		return nil
+	}
+
+	switch falconContainer.Spec.Registry.Type {
+	case v1beta1.RegistryTypeECR:
+		// The repository may be shared with other clusters, only the one the operator created is deleted
+		if falconContainer.Status.CreatedECRRepository == "" {
+			log.Info("Skipping deletion of ECR repository not created by the operator", "repository", ecrRepoName)
+			return nil
+		}
+		log.Info("Deleting ECR repository", "repository", falconContainer.Status.CreatedECRRepository)
+		return r.DeleteECRRepo(ctx, falconContainer)
+	case v1beta1.RegistryTypeOpenshift:
+		imageStream := &imagev1.ImageStream{}
+		return r.deleteIfControlled(ctx, log, falconContainer, types.NamespacedName{Name: imageStreamName, Namespace: r.imageNamespace(falconContainer)}, imageStream)
+	}
+
+	return nil
+}
+
+func (r *FalconContainerReconciler) finalizeNamespace(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) error {
+	namespace := &corev1.Namespace{}
+	return r.deleteIfControlled(ctx, log, falconContainer, types.NamespacedName{Name: falconContainer.TargetNs()}, namespace)
+}
+
+// deleteIfControlled deletes the named object only when it is controlled by the FalconContainer
+func (r *FalconContainerReconciler) deleteIfControlled(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, key types.NamespacedName, obj client.Object) error {
+	if err := r.Client.Get(ctx, key, obj); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to query %T %s: %v", obj, key, err)
+	}
+
+	if !metav1.IsControlledBy(obj, falconContainer) {
+		log.Info("Skipping deletion of object not controlled by FalconContainer", "name", key.Name, "namespace", key.Namespace)
+		return nil
+	}
+
+	log.Info(fmt.Sprintf("Deleting Falcon Container object %T %s", obj, key))
+	if err := r.Client.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete %T %s: %v", obj, key, err)
+	}
+
+	return nil
+}
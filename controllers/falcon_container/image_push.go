@@ -4,24 +4,27 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
+	"path/filepath"
+	"time"
 
+	imagetypes "github.com/containers/image/v5/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/gcp"
-	"github.com/crowdstrike/falcon-operator/pkg/k8s_utils"
+	"github.com/crowdstrike/falcon-operator/pkg/registry"
 	"github.com/crowdstrike/falcon-operator/pkg/registry/auth"
 	"github.com/crowdstrike/falcon-operator/pkg/registry/falcon_registry"
-	"github.com/crowdstrike/falcon-operator/pkg/registry/pushtoken"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/mirror"
 	"github.com/crowdstrike/gofalcon/falcon"
 	"github.com/go-logr/logr"
 	imagev1 "github.com/openshift/api/image/v1"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	types "k8s.io/apimachinery/pkg/types"
 )
 
-func (r *FalconContainerReconciler) PushImage(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) error {
+func (r *FalconContainerReconciler) PushImage(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) error {
 	registryUri, err := r.registryUri(ctx, falconContainer)
 	if err != nil {
 		return err
@@ -32,8 +35,18 @@ func (r *FalconContainerReconciler) PushImage(ctx context.Context, log logr.Logg
 		return err
 	}
 
+	apiConfig, err := r.falconApiConfig(ctx, falconContainer)
+	if err != nil {
+		return err
+	}
+
+	certDir, err := r.registryCertDir(ctx, falconContainer)
+	if err != nil {
+		return err
+	}
+
 	log.Info("Found secret for image push", "Secret.Name", pushAuth.Name())
-	image := NewImageRefresher(ctx, log, r.falconApiConfig(ctx, falconContainer), pushAuth, falconContainer.Spec.Registry.TLS.InsecureSkipVerify)
+	image := NewImageRefresher(ctx, log, apiConfig, pushAuth, falconContainer.Spec.Registry.TLS.InsecureSkipVerify, certDir, falconContainer.Spec.ImageVerification)
 	version := falconContainer.Spec.Version
 
 	// If we have version locking enabled (as it is by default), use the already configured version if present
@@ -64,7 +77,7 @@ func (r *FalconContainerReconciler) PushImage(ctx context.Context, log logr.Logg
 	return r.Client.Status().Update(ctx, falconContainer)
 }
 
-func (r *FalconContainerReconciler) verifyCrowdStrikeRegistry(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (bool, error) {
+func (r *FalconContainerReconciler) verifyCrowdStrikeRegistry(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (bool, error) {
 	if _, err := r.setImageTag(ctx, falconContainer); err != nil {
 		return false, fmt.Errorf("Cannot set Falcon Registry Tag: %s", err)
 	}
@@ -75,25 +88,25 @@ func (r *FalconContainerReconciler) verifyCrowdStrikeRegistry(ctx context.Contex
 		return false, fmt.Errorf("Cannot find Falcon Registry URI: %s", err)
 	}
 
-	condition := meta.IsStatusConditionPresentAndEqual(falconContainer.Status.Conditions, v1alpha1.ConditionImageReady, metav1.ConditionTrue)
+	condition := meta.IsStatusConditionPresentAndEqual(falconContainer.Status.Conditions, v1beta1.ConditionImageReady, metav1.ConditionTrue)
 	if condition {
 		return false, nil
 	}
 
 	meta.SetStatusCondition(&falconContainer.Status.Conditions, metav1.Condition{
 		Status:             metav1.ConditionTrue,
-		Reason:             v1alpha1.ReasonDiscovered,
+		Reason:             v1beta1.ReasonDiscovered,
 		Message:            imageUri,
-		Type:               v1alpha1.ConditionImageReady,
+		Type:               v1beta1.ConditionImageReady,
 		ObservedGeneration: falconContainer.GetGeneration(),
 	})
 
 	return true, r.Client.Status().Update(ctx, falconContainer)
 }
 
-func (r *FalconContainerReconciler) registryUri(ctx context.Context, falconContainer *v1alpha1.FalconContainer) (string, error) {
+func (r *FalconContainerReconciler) registryUri(ctx context.Context, falconContainer *v1beta1.FalconContainer) (string, error) {
 	switch falconContainer.Spec.Registry.Type {
-	case v1alpha1.RegistryTypeOpenshift:
+	case v1beta1.RegistryTypeOpenshift:
 		imageStream := &imagev1.ImageStream{}
 		err := r.Client.Get(ctx, types.NamespacedName{Name: imageStreamName, Namespace: r.imageNamespace(falconContainer)}, imageStream)
 		if err != nil {
@@ -105,39 +118,41 @@ func (r *FalconContainerReconciler) registryUri(ctx context.Context, falconConta
 		}
 
 		return imageStream.Status.DockerImageRepository, nil
-	case v1alpha1.RegistryTypeGCR:
+	case v1beta1.RegistryTypeGCR:
 		projectId, err := gcp.GetProjectID()
 		if err != nil {
 			return "", fmt.Errorf("Cannot get GCP Project ID: %v", err)
 		}
 
 		return "gcr.io/" + projectId + "/falcon-container", nil
-	case v1alpha1.RegistryTypeECR:
-		repo, err := r.UpsertECRRepo(ctx)
+	case v1beta1.RegistryTypeECR:
+		repo, err := r.UpsertECRRepo(ctx, falconContainer)
 		if err != nil {
 			return "", fmt.Errorf("Cannot get target docker URI for ECR repository: %v", err)
 		}
 
 		return *repo.RepositoryUri, nil
-	case v1alpha1.RegistryTypeACR:
-		if falconContainer.Spec.Registry.AcrName == nil {
-			return "", fmt.Errorf("Cannot push Falcon Image locally to ACR. acr_name was not specified")
+	case v1beta1.RegistryTypeACR:
+		if falconContainer.Spec.Registry.ACRName == nil {
+			return "", fmt.Errorf("Cannot push Falcon Image locally to ACR. acrName was not specified")
 		}
 
-		return fmt.Sprintf("%s.azurecr.io/falcon-container", *falconContainer.Spec.Registry.AcrName), nil
-	case v1alpha1.RegistryTypeCrowdStrike:
-		cloud, err := falconContainer.Spec.FalconAPI.FalconCloud(ctx)
+		return fmt.Sprintf("%s.azurecr.io/falcon-container", *falconContainer.Spec.Registry.ACRName), nil
+	case v1beta1.RegistryTypeCrowdStrike:
+		cloud, err := falconContainer.Spec.FalconAPI.FalconCloud(ctx, r.Client)
 		if err != nil {
 			return "", err
 		}
 
 		return falcon_registry.ImageURIContainer(cloud), nil
+	case v1beta1.RegistryTypeGeneric:
+		return mirror.GenericRepository(falconContainer.Spec.Registry.URI)
 	default:
 		return "", fmt.Errorf("Unrecognized registry type: %s", falconContainer.Spec.Registry.Type)
 	}
 }
 
-func (r *FalconContainerReconciler) imageUri(ctx context.Context, falconContainer *v1alpha1.FalconContainer) (string, error) {
+func (r *FalconContainerReconciler) imageUri(ctx context.Context, falconContainer *v1beta1.FalconContainer) (string, error) {
 	if falconContainer.Spec.Image != nil && *falconContainer.Spec.Image != "" {
 		return *falconContainer.Spec.Image, nil
 	}
@@ -157,10 +172,104 @@ func (r *FalconContainerReconciler) imageUri(ctx context.Context, falconContaine
 		return "", fmt.Errorf("failed to set Falcon Container Image version: %v", err)
 	}
 
-	return fmt.Sprintf("%s:%s", registryUri, imageTag), nil
+	// Deploy by digest, so that a tag pushed again cannot change the image that is running
+	image := fmt.Sprintf("%s:%s", registryUri, imageTag)
+	digest, err := r.imageDigest(ctx, falconContainer, image)
+	if err != nil {
+		return "", err
+	}
+
+	if digest != falconContainer.Status.ImageDigest {
+		// The tag was pushed again, the injector is rolled to the new image
+		if previous := falconContainer.Status.ImageDigest; previous != "" && falconContainer.Status.Sensor != nil && *falconContainer.Status.Sensor == imageTag {
+			r.Recorder.Eventf(falconContainer, corev1.EventTypeWarning, v1beta1.ReasonImageDigestChanged,
+				"Falcon Container image %s now resolves to %s instead of %s", image, digest, previous)
+		}
+		falconContainer.Status.ImageDigest = digest
+	}
+
+	return registry.PinImage(image, digest)
+}
+
+// imageDigestsKey is the context key of the image digests resolved during one reconcile
+type imageDigestsKey struct{}
+
+// withImageDigests returns a context caching the image digests looked up during one reconcile, so that the steps handing the
+// image to the injector do not each ask the registry again
+func withImageDigests(ctx context.Context) context.Context {
+	return context.WithValue(ctx, imageDigestsKey{}, map[string]string{})
+}
+
+// imageDigest returns the manifest digest of the image, looking it up in the registry once per reconcile
+func (r *FalconContainerReconciler) imageDigest(ctx context.Context, falconContainer *v1beta1.FalconContainer, image string) (string, error) {
+	digests, _ := ctx.Value(imageDigestsKey{}).(map[string]string)
+	if digest, ok := digests[image]; ok {
+		return digest, nil
+	}
+
+	sys, _, err := r.registrySystemContext(ctx, falconContainer)
+	if err != nil {
+		return "", err
+	}
+
+	digest, err := registry.ImageDigest(ctx, sys, image)
+	if err != nil {
+		return "", err
+	}
+
+	if digests != nil {
+		digests[image] = digest
+	}
+	return digest, nil
+}
+
+// registrySystemContext returns the credentials used to read the Falcon Container image from where it is deployed from,
+// along with the CrowdStrike repository the image was originally signed for when it is mirrored
+func (r *FalconContainerReconciler) registrySystemContext(ctx context.Context, falconContainer *v1beta1.FalconContainer) (*imagetypes.SystemContext, string, error) {
+	switch {
+	case falconContainer.Spec.Image != nil && *falconContainer.Spec.Image != "":
+		// Images provided by the user are read anonymously
+		return nil, "", nil
+	case os.Getenv("RELATED_IMAGE_SIDECAR_SENSOR") != "" && falconContainer.Spec.FalconAPI == nil:
+		return nil, "", nil
+	case r.imageMirroringEnabled(falconContainer):
+		pushAuth, err := r.pushAuth(ctx, falconContainer)
+		if err != nil {
+			return nil, "", err
+		}
+		certDir, err := r.registryCertDir(ctx, falconContainer)
+		if err != nil {
+			return nil, "", err
+		}
+		sys, err := mirror.DestinationContext(pushAuth, falconContainer.Spec.Registry.TLS, certDir)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// The mirrored image carries the signatures made for the CrowdStrike registry
+		cloud, err := falconContainer.Spec.FalconAPI.FalconCloud(ctx, r.Client)
+		if err != nil {
+			return nil, "", err
+		}
+		return sys, falcon_registry.ImageURIContainer(cloud), nil
+	default:
+		apiConfig, err := r.falconApiConfig(ctx, falconContainer)
+		if err != nil {
+			return nil, "", err
+		}
+		falconRegistry, err := falcon_registry.NewFalconRegistry(ctx, apiConfig)
+		if err != nil {
+			return nil, "", err
+		}
+		sys, err := falconRegistry.SystemContext()
+		if err != nil {
+			return nil, "", err
+		}
+		return sys, "", nil
+	}
 }
 
-func (r *FalconContainerReconciler) getImageTag(ctx context.Context, falconContainer *v1alpha1.FalconContainer) (string, error) {
+func (r *FalconContainerReconciler) getImageTag(ctx context.Context, falconContainer *v1beta1.FalconContainer) (string, error) {
 	if falconContainer.Status.Sensor != nil && *falconContainer.Status.Sensor != "" {
 		return *falconContainer.Status.Sensor, nil
 	}
@@ -168,7 +277,7 @@ func (r *FalconContainerReconciler) getImageTag(ctx context.Context, falconConta
 	return "", fmt.Errorf("Unable to get falcon container version")
 }
 
-func (r *FalconContainerReconciler) setImageTag(ctx context.Context, falconContainer *v1alpha1.FalconContainer) (string, error) {
+func (r *FalconContainerReconciler) setImageTag(ctx context.Context, falconContainer *v1beta1.FalconContainer) (string, error) {
 	// If version locking is enabled and a version is already set in status, return the current version
 	if r.versionLock(falconContainer) {
 		if tag, err := r.getImageTag(ctx, falconContainer); err == nil {
@@ -178,20 +287,29 @@ func (r *FalconContainerReconciler) setImageTag(ctx context.Context, falconConta
 
 	// If an Image URI is set, use it for our version
 	if falconContainer.Spec.Image != nil && *falconContainer.Spec.Image != "" {
-		falconContainer.Status.Sensor = &strings.Split(*falconContainer.Spec.Image, ":")[1]
+		tag, digest := registry.SplitImage(*falconContainer.Spec.Image)
+		falconContainer.Status.Sensor = &tag
+		falconContainer.Status.ImageDigest = digest
 
 		return *falconContainer.Status.Sensor, r.Client.Status().Update(ctx, falconContainer)
 	}
 
 	if os.Getenv("RELATED_IMAGE_SIDECAR_SENSOR") != "" && falconContainer.Spec.FalconAPI == nil {
 		image := os.Getenv("RELATED_IMAGE_SIDECAR_SENSOR")
-		falconContainer.Status.Sensor = &strings.Split(image, ":")[1]
+		tag, digest := registry.SplitImage(image)
+		falconContainer.Status.Sensor = &tag
+		falconContainer.Status.ImageDigest = digest
 
 		return *falconContainer.Status.Sensor, r.Client.Status().Update(ctx, falconContainer)
 	}
 
 	// Otherwise, get the newest version matching the requested version string
-	registry, err := falcon_registry.NewFalconRegistry(ctx, r.falconApiConfig(ctx, falconContainer))
+	apiConfig, err := r.falconApiConfig(ctx, falconContainer)
+	if err != nil {
+		return "", err
+	}
+
+	registry, err := falcon_registry.NewFalconRegistry(ctx, apiConfig)
 	if err != nil {
 		return "", err
 	}
@@ -204,32 +322,40 @@ func (r *FalconContainerReconciler) setImageTag(ctx context.Context, falconConta
 	return tag, err
 }
 
-func (r *FalconContainerReconciler) pushAuth(ctx context.Context, falconContainer *v1alpha1.FalconContainer) (auth.Credentials, error) {
-	return pushtoken.GetCredentials(ctx, falconContainer.Spec.Registry.Type,
-		k8s_utils.QuerySecretsInNamespace(r.Client, r.imageNamespace(falconContainer)),
-	)
+func (r *FalconContainerReconciler) pushAuth(ctx context.Context, falconContainer *v1beta1.FalconContainer) (auth.Credentials, error) {
+	return mirror.Credentials(ctx, r.Client, &falconContainer.Spec.Registry, r.imageNamespace(falconContainer))
 }
 
-func (r *FalconContainerReconciler) imageNamespace(falconContainer *v1alpha1.FalconContainer) string {
-	if falconContainer.Spec.Registry.Type == v1alpha1.RegistryTypeOpenshift {
+// registryCertDir writes the CA certificates configured for the registry to a directory that is used as DockerCertPath
+func (r *FalconContainerReconciler) registryCertDir(ctx context.Context, falconContainer *v1beta1.FalconContainer) (string, error) {
+	return mirror.CertDir(ctx, r.Client, falconContainer.Spec.Registry.TLS, falconContainer.TargetNs(), filepath.Join("falconcontainer", falconContainer.Name))
+}
+
+func (r *FalconContainerReconciler) imageNamespace(falconContainer *v1beta1.FalconContainer) string {
+	if falconContainer.Spec.Registry.Type == v1beta1.RegistryTypeOpenshift {
 		// Within OpenShift, ImageStreams are separated by namespaces. The "openshift" namespace
 		// is shared and images pushed there can be referenced by deployments in other namespaces
 		return "openshift"
 	}
-	return r.Namespace()
+	return falconContainer.TargetNs()
 }
 
-func (r *FalconContainerReconciler) falconApiConfig(ctx context.Context, falconContainer *v1alpha1.FalconContainer) *falcon.ApiConfig {
-	cfg := falconContainer.Spec.FalconAPI.ApiConfig()
-	cfg.Context = ctx
+func (r *FalconContainerReconciler) falconApiConfig(ctx context.Context, falconContainer *v1beta1.FalconContainer) (*falcon.ApiConfig, error) {
+	if falconContainer.Spec.FalconAPI == nil {
+		return nil, fmt.Errorf("Missing falconAPI configuration")
+	}
 
-	return cfg
+	return falconContainer.Spec.FalconAPI.ApiConfig(ctx, r.Client)
 }
 
-func (r *FalconContainerReconciler) imageMirroringEnabled(falconContainer *v1alpha1.FalconContainer) bool {
-	return falconContainer.Spec.Registry.Type != v1alpha1.RegistryTypeCrowdStrike
+func (r *FalconContainerReconciler) imageMirroringEnabled(falconContainer *v1beta1.FalconContainer) bool {
+	return falconContainer.Spec.Registry.Type != v1beta1.RegistryTypeCrowdStrike
 }
 
-func (r *FalconContainerReconciler) versionLock(falconContainer *v1alpha1.FalconContainer) bool {
+func (r *FalconContainerReconciler) versionLock(falconContainer *v1beta1.FalconContainer) bool {
+	// A due image refresh looks up the version again, so that a tag pushed again is mirrored again
+	if imageRefreshDue(falconContainer, time.Now()) {
+		return false
+	}
 	return falconContainer.Spec.Version != nil && falconContainer.Status.Sensor != nil && *falconContainer.Spec.Version == *falconContainer.Status.Sensor
 }
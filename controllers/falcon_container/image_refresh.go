@@ -0,0 +1,87 @@
+package falcon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// sensorImage identifies the Falcon Container image handed to the injector
+type sensorImage struct {
+	tag    string
+	digest string
+}
+
+func currentSensorImage(falconContainer *v1beta1.FalconContainer) sensorImage {
+	image := sensorImage{digest: falconContainer.Status.ImageDigest}
+	if falconContainer.Status.Sensor != nil {
+		image.tag = *falconContainer.Status.Sensor
+	}
+	return image
+}
+
+func (i sensorImage) String() string {
+	if i.digest == "" {
+		return i.tag
+	}
+	return fmt.Sprintf("%s (%s)", i.tag, i.digest)
+}
+
+// imageRefreshEnabled reports whether the operator looks up the Falcon Container image on a schedule
+func imageRefreshEnabled(falconContainer *v1beta1.FalconContainer) bool {
+	if falconContainer.ImageRefreshInterval() == 0 {
+		return false
+	}
+	if falconContainer.Spec.Image != nil && *falconContainer.Spec.Image != "" {
+		return false
+	}
+	return os.Getenv("RELATED_IMAGE_SIDECAR_SENSOR") == "" || falconContainer.Spec.FalconAPI != nil
+}
+
+// imageRefreshDue reports whether the refresh interval passed since the last lookup of the Falcon Container image
+func imageRefreshDue(falconContainer *v1beta1.FalconContainer, now time.Time) bool {
+	if !imageRefreshEnabled(falconContainer) {
+		return false
+	}
+	last := falconContainer.Status.LastImageRefresh
+	return last == nil || !now.Before(last.Add(falconContainer.ImageRefreshInterval()))
+}
+
+// imageRefreshRequeueAfter returns how long until the next lookup of the Falcon Container image, or 0 when refresh is disabled
+func imageRefreshRequeueAfter(falconContainer *v1beta1.FalconContainer, now time.Time) time.Duration {
+	if !imageRefreshEnabled(falconContainer) || falconContainer.Status.LastImageRefresh == nil {
+		return 0
+	}
+	next := falconContainer.Status.LastImageRefresh.Add(falconContainer.ImageRefreshInterval())
+	if !next.After(now) {
+		return time.Second
+	}
+	return next.Sub(now)
+}
+
+// completeImageRefresh emits an Event when the Falcon Container version handed to the injector changed, and records the
+// time of a periodic image lookup. A tag pushed again with another digest is reported by imageUri.
+func (r *FalconContainerReconciler) completeImageRefresh(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, previous sensorImage, refreshed bool) error {
+	current := currentSensorImage(falconContainer)
+	if current.tag != previous.tag && current.tag != "" {
+		message := fmt.Sprintf("Falcon Container sensor set to %s", current)
+		if previous.tag != "" {
+			message = fmt.Sprintf("Falcon Container sensor changed from %s to %s", previous, current)
+		}
+		log.Info(message)
+		r.Recorder.Event(falconContainer, corev1.EventTypeNormal, v1beta1.ReasonSensorVersionChanged, message)
+	}
+
+	if !refreshed {
+		return nil
+	}
+
+	falconContainer.Status.LastImageRefresh = &metav1.Time{Time: time.Now()}
+	return r.Client.Status().Update(ctx, falconContainer)
+}
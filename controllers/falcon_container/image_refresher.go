@@ -3,18 +3,15 @@ package falcon
 import (
 	"context"
 	"fmt"
-	"os"
-	"strings"
 
 	"github.com/go-logr/logr"
 
-	"github.com/containers/image/v5/copy"
-	"github.com/containers/image/v5/signature"
-	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/image/v5/types"
 
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/registry/auth"
 	"github.com/crowdstrike/falcon-operator/pkg/registry/falcon_registry"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/mirror"
 	"github.com/crowdstrike/gofalcon/falcon"
 )
 
@@ -23,16 +20,20 @@ type ImageRefresher struct {
 	log                   logr.Logger
 	falconConfig          *falcon.ApiConfig
 	insecureSkipTLSVerify bool
+	registryCertDir       string
 	pushCredentials       auth.Credentials
+	imageVerification     *v1beta1.ImageVerificationSpec
 }
 
-func NewImageRefresher(ctx context.Context, log logr.Logger, falconConfig *falcon.ApiConfig, pushAuth auth.Credentials, insecureSkipTLSVerify bool) *ImageRefresher {
+func NewImageRefresher(ctx context.Context, log logr.Logger, falconConfig *falcon.ApiConfig, pushAuth auth.Credentials, insecureSkipTLSVerify bool, registryCertDir string, imageVerification *v1beta1.ImageVerificationSpec) *ImageRefresher {
 	return &ImageRefresher{
 		ctx:                   ctx,
 		log:                   log,
 		falconConfig:          falconConfig,
 		insecureSkipTLSVerify: insecureSkipTLSVerify,
+		registryCertDir:       registryCertDir,
 		pushCredentials:       pushAuth,
+		imageVerification:     imageVerification,
 	}
 }
 
@@ -44,55 +45,18 @@ func (r *ImageRefresher) Refresh(imageDestination string, versionRequested *stri
 
 	r.log.Info("Identified the latest Falcon Container image", "reference", srcRef.DockerReference().String())
 
-	policy := &signature.Policy{Default: []signature.PolicyRequirement{signature.NewPRInsecureAcceptAnything()}}
-	policyContext, err := signature.NewPolicyContext(policy)
-	if err != nil {
-		return "", fmt.Errorf("Error loading trust policy: %v", err)
-	}
-	defer func() { _ = policyContext.Destroy() }()
-
 	destinationCtx, err := r.destinationContext(r.insecureSkipTLSVerify)
 	if err != nil {
 		return "", err
 	}
 
 	// Push to the registry with the falconTag
-	dest := fmt.Sprintf("docker://%s:%s", imageDestination, falconTag)
-	destRef, err := alltransports.ParseImageName(dest)
-
+	err = mirror.Copy(r.ctx, r.log, srcRef, sourceCtx, fmt.Sprintf("%s:%s", imageDestination, falconTag), destinationCtx, r.imageVerification)
 	if err != nil {
-		return "", fmt.Errorf("Invalid destination name %s: %v", dest, err)
-	}
-
-	r.log.Info("Identified the target location for image push", "reference", destRef.DockerReference().String())
-	_, err = copy.Image(r.ctx, policyContext, destRef, srcRef,
-		&copy.Options{
-			ReportWriter:   os.Stdout,
-			SourceCtx:      sourceCtx,
-			DestinationCtx: destinationCtx,
-		},
-	)
-	if err != nil {
-		return "", wrapWithHint(err)
-	}
-
-	// Push to the registry with the latest tag
-	dest = fmt.Sprintf("docker://%s", imageDestination)
-	destRef, err = alltransports.ParseImageName(dest)
-	if err != nil {
-		return "", fmt.Errorf("Invalid destination name %s: %v", dest, err)
+		return "", err
 	}
 
-	r.log.Info("Identified the target location for image push", "reference", destRef.DockerReference().String())
-	_, err = copy.Image(r.ctx, policyContext, destRef, srcRef,
-		&copy.Options{
-			ReportWriter:   os.Stdout,
-			SourceCtx:      sourceCtx,
-			DestinationCtx: destinationCtx,
-		},
-	)
-
-	return falconTag, wrapWithHint(err)
+	return falconTag, nil
 }
 
 func (r *ImageRefresher) source(versionRequested *string) (falconTag string, falconImage types.ImageReference, systemContext *types.SystemContext, err error) {
@@ -105,28 +69,5 @@ func (r *ImageRefresher) source(versionRequested *string) (falconTag string, fal
 }
 
 func (r *ImageRefresher) destinationContext(insecureSkipTLSVerify bool) (*types.SystemContext, error) {
-	ctx, err := r.pushCredentials.DestinationContext()
-	if err != nil {
-		return nil, err
-	}
-
-	if insecureSkipTLSVerify {
-		ctx.DockerInsecureSkipTLSVerify = 1
-	}
-
-	return ctx, nil
-}
-
-func wrapWithHint(in error) error {
-	// Use of credentials store outside of docker command is somewhat limited
-	// See https://github.com/moby/moby/issues/39377
-	// https://github.com/containers/image/pull/656
-	if in == nil {
-		return in
-	}
-
-	if strings.Contains(in.Error(), "authentication required") {
-		return fmt.Errorf("Could not authenticate to the registry: %w", in)
-	}
-	return in
+	return mirror.DestinationContext(r.pushCredentials, v1beta1.RegistryTLSSpec{InsecureSkipVerify: insecureSkipTLSVerify}, r.registryCertDir)
 }
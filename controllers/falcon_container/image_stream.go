@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -19,7 +19,7 @@ const (
 	imageStreamName = "falcon-sidecar-container"
 )
 
-func (r *FalconContainerReconciler) reconcileImageStream(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*imagev1.ImageStream, error) {
+func (r *FalconContainerReconciler) reconcileImageStream(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*imagev1.ImageStream, error) {
 	imageStream := r.newImageStream(falconContainer)
 	existingImageStream := &imagev1.ImageStream{}
 
@@ -45,7 +45,7 @@ func (r *FalconContainerReconciler) reconcileImageStream(ctx context.Context, lo
 	return existingImageStream, r.Update(ctx, log, falconContainer, existingImageStream)
 }
 
-func (r *FalconContainerReconciler) newImageStream(falconContainer *v1alpha1.FalconContainer) *imagev1.ImageStream {
+func (r *FalconContainerReconciler) newImageStream(falconContainer *v1beta1.FalconContainer) *imagev1.ImageStream {
 	return &imagev1.ImageStream{
 		TypeMeta:   metav1.TypeMeta{APIVersion: imagev1.SchemeGroupVersion.String(), Kind: "ImageStream"},
 		ObjectMeta: metav1.ObjectMeta{Name: imageStreamName, Namespace: r.imageNamespace(falconContainer)},
@@ -0,0 +1,61 @@
+package falcon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/verification"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// verifyImage checks the signature of the Falcon Container image before it is rolled out to the injector
+// Deployment and records the outcome in the ImageVerified condition
+func (r *FalconContainerReconciler) verifyImage(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, imageUri string) error {
+	if !verification.Enabled(falconContainer.Spec.ImageVerification) {
+		return nil
+	}
+
+	err := r.verifyImageSignature(ctx, falconContainer, imageUri)
+	if err != nil {
+		log.Error(err, "Falcon Container image failed signature verification", "image", imageUri)
+		meta.SetStatusCondition(&falconContainer.Status.Conditions, metav1.Condition{
+			Type:               v1beta1.ConditionImageVerified,
+			Status:             metav1.ConditionFalse,
+			Reason:             v1beta1.ReasonVerificationFailed,
+			Message:            err.Error(),
+			ObservedGeneration: falconContainer.GetGeneration(),
+		})
+		if updateErr := r.Client.Status().Update(ctx, falconContainer); updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	meta.SetStatusCondition(&falconContainer.Status.Conditions, metav1.Condition{
+		Type:               v1beta1.ConditionImageVerified,
+		Status:             metav1.ConditionTrue,
+		Reason:             v1beta1.ReasonVerificationSucceeded,
+		Message:            fmt.Sprintf("Image %s passed signature verification", imageUri),
+		ObservedGeneration: falconContainer.GetGeneration(),
+	})
+	return r.Client.Status().Update(ctx, falconContainer)
+}
+
+func (r *FalconContainerReconciler) verifyImageSignature(ctx context.Context, falconContainer *v1beta1.FalconContainer, imageUri string) error {
+	ref, err := docker.ParseReference("//" + imageUri)
+	if err != nil {
+		return fmt.Errorf("Invalid image reference %s: %v", imageUri, err)
+	}
+
+	sys, signedRepository, err := r.registrySystemContext(ctx, falconContainer)
+	if err != nil {
+		return err
+	}
+
+	_, err = verification.Verify(ctx, falconContainer.Spec.ImageVerification, ref, sys, signedRepository)
+	return err
+}
@@ -1,20 +1,26 @@
 package falcon
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"reflect"
+	"time"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/go-logr/logr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/crowdstrike/falcon-operator/pkg/tls"
+	arv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -28,6 +34,12 @@ const (
 	injectorTLSSecretName         = "falcon-sidecar-injector-tls"
 	falconVolumeName              = "crowdstrike-falcon-volume"
 	falconVolumePath              = "/tmp/CrowdStrike"
+
+	// injectorTLSPreviousCAKey holds the CA that was replaced during a certificate rotation. It stays
+	// trusted by the webhook until every injector replica serves the new certificate.
+	injectorTLSPreviousCAKey = "previous-ca.crt"
+	// injectorTLSHashAnnotation rolls the injector Deployment when the serving certificate changes
+	injectorTLSHashAnnotation = "falcon.crowdstrike.com/injector-tls-hash"
 )
 
 var (
@@ -42,20 +54,27 @@ var (
 	}
 )
 
-func (r *FalconContainerReconciler) reconcileInjectorTLSSecret(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.Secret, error) {
+func (r *FalconContainerReconciler) reconcileInjectorTLSSecret(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.Secret, error) {
+	if certManagerEnabled(falconContainer) {
+		return r.reconcileCertManagerTLS(ctx, log, falconContainer)
+	}
+
+	// Clean up after a previous cert-manager configuration
+	if meta.FindStatusCondition(falconContainer.Status.Conditions, certManagerCertificateCondition) != nil {
+		if err := r.deleteCertManagerObjects(ctx, log, falconContainer); err != nil {
+			return &corev1.Secret{}, err
+		}
+	}
+
 	existingInjectorTLSSecret := &corev1.Secret{}
-	err := r.Client.Get(ctx, types.NamespacedName{Name: injectorTLSSecretName, Namespace: r.Namespace()}, existingInjectorTLSSecret)
+	err := r.Client.Get(ctx, types.NamespacedName{Name: injectorTLSSecretName, Namespace: falconContainer.TargetNs()}, existingInjectorTLSSecret)
 	if err != nil {
 		if errors.IsNotFound(err) {
-			validity := 3650
-			if falconContainer.Spec.Injector.TLS.Validity != nil {
-				validity = *falconContainer.Spec.Injector.TLS.Validity
-			}
-			c, k, b, err := tls.CertSetup(validity)
+			c, k, b, err := tls.CertSetup(falconContainer.TargetNs(), falconContainer.InjectorTLSValidity(), injectorKeyAlgorithm(falconContainer))
 			if err != nil {
 				return &corev1.Secret{}, fmt.Errorf("failed to generate Falcon Container PKI: %v", err)
 			}
-			injectorTLSSecret := r.newInjectorTLSSecret(c, k, b)
+			injectorTLSSecret := r.newInjectorTLSSecret(c, k, b, falconContainer)
 			if err = ctrl.SetControllerReference(falconContainer, injectorTLSSecret, r.Scheme); err != nil {
 				return &corev1.Secret{}, fmt.Errorf("unable to set controller reference on injector TLS Secret%s: %v", injectorTLSSecret.ObjectMeta.Name, err)
 			}
@@ -63,11 +82,132 @@ func (r *FalconContainerReconciler) reconcileInjectorTLSSecret(ctx context.Conte
 		}
 		return &corev1.Secret{}, fmt.Errorf("unable to query existing injector TL secret %s: %v", injectorTLSSecretName, err)
 	}
-	return existingInjectorTLSSecret, nil
 
+	renewAt, err := injectorTLSRenewalTime(existingInjectorTLSSecret, falconContainer)
+	if err == nil && time.Now().Before(renewAt) {
+		return existingInjectorTLSSecret, nil
+	}
+	if err != nil {
+		log.Info("Regenerating unusable injector TLS certificate", "reason", err.Error())
+	} else {
+		log.Info("Renewing injector TLS certificate", "renewAt", renewAt)
+	}
+
+	c, k, b, err := tls.CertSetup(falconContainer.TargetNs(), falconContainer.InjectorTLSValidity(), injectorKeyAlgorithm(falconContainer))
+	if err != nil {
+		return &corev1.Secret{}, fmt.Errorf("failed to generate Falcon Container PKI: %v", err)
+	}
+
+	// Trust both the old and the new CA before any injector replica starts serving the new certificate
+	previousCA := existingInjectorTLSSecret.Data["ca.crt"]
+	if err = r.patchWebhookCABundle(ctx, log, falconContainer, append(append([]byte{}, b...), previousCA...)); err != nil {
+		return &corev1.Secret{}, err
+	}
+
+	existingInjectorTLSSecret.Data = map[string][]byte{
+		"tls.crt":                c,
+		"tls.key":                k,
+		"ca.crt":                 b,
+		injectorTLSPreviousCAKey: previousCA,
+	}
+	return existingInjectorTLSSecret, r.Update(ctx, log, falconContainer, existingInjectorTLSSecret)
+}
+
+// injectorTLSRenewalTime returns when the injector TLS certificate enters its renewal window
+func injectorTLSRenewalTime(injectorTLS *corev1.Secret, falconContainer *v1beta1.FalconContainer) (time.Time, error) {
+	renewBefore := falconContainer.InjectorTLSRenewBefore()
+
+	cert, err := tls.ParseCert(injectorTLS.Data["tls.crt"])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid injector certificate: %v", err)
+	}
+	if got, want := tls.KeyAlgorithmOf(cert), injectorKeyAlgorithm(falconContainer); got != want {
+		return time.Time{}, fmt.Errorf("key algorithm changed from %q to %q", got, want)
+	}
+	caNotAfter, err := tls.CertNotAfter(injectorTLS.Data["ca.crt"])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid injector CA: %v", err)
+	}
+
+	notAfter := cert.NotAfter
+	if caNotAfter.Before(notAfter) {
+		notAfter = caNotAfter
+	}
+
+	// Never renew a certificate more often than every half of its lifetime
+	window := time.Duration(renewBefore) * 24 * time.Hour
+	if lifetime := notAfter.Sub(cert.NotBefore); window > lifetime/2 {
+		window = lifetime / 2
+	}
+
+	return notAfter.Add(-window), nil
+}
+
+// injectorKeyAlgorithm returns the key algorithm requested for the injector certificates
+func injectorKeyAlgorithm(falconContainer *v1beta1.FalconContainer) tls.KeyAlgorithm {
+	if falconContainer.Spec.Injector.TLS.KeyAlgorithm == "" {
+		return tls.DefaultKeyAlgorithm
+	}
+	return tls.KeyAlgorithm(falconContainer.Spec.Injector.TLS.KeyAlgorithm)
+}
+
+// injectorCABundle returns the CA bundle the webhook should trust, including the previous CA during a rotation
+func injectorCABundle(injectorTLS *corev1.Secret) []byte {
+	return append(append([]byte{}, injectorTLS.Data["ca.crt"]...), injectorTLS.Data[injectorTLSPreviousCAKey]...)
+}
+
+// injectorTLSHash identifies the serving certificate the injector replicas should run with
+func injectorTLSHash(injectorTLS *corev1.Secret) string {
+	sum := sha256.Sum256(injectorTLS.Data["tls.crt"])
+	return hex.EncodeToString(sum[:])
+}
+
+// patchWebhookCABundle updates the CA bundle of an existing injector MutatingWebhookConfiguration
+func (r *FalconContainerReconciler) patchWebhookCABundle(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, caBundle []byte) error {
+	existingWebhook := &arv1.MutatingWebhookConfiguration{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: webhookName}, existingWebhook)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to query existing mutating webhook configuration %s: %v", webhookName, err)
+	}
+
+	updated := false
+	for i := range existingWebhook.Webhooks {
+		if !bytes.Equal(existingWebhook.Webhooks[i].ClientConfig.CABundle, caBundle) {
+			existingWebhook.Webhooks[i].ClientConfig.CABundle = caBundle
+			updated = true
+		}
+	}
+
+	if updated {
+		return r.Update(ctx, log, falconContainer, existingWebhook)
+	}
+
+	return nil
+}
+
+// completeInjectorTLSRotation stops trusting the previous CA once every injector replica serves the new certificate
+func (r *FalconContainerReconciler) completeInjectorTLSRotation(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, injectorTLS *corev1.Secret, deployment *appsv1.Deployment) error {
+	if _, ok := injectorTLS.Data[injectorTLSPreviousCAKey]; !ok {
+		return nil
+	}
+
+	if deployment.Spec.Template.Annotations[injectorTLSHashAnnotation] != injectorTLSHash(injectorTLS) || !deploymentRolledOut(deployment) {
+		return nil
+	}
+
+	log.Info("Injector TLS certificate rotation completed; removing previous CA")
+	delete(injectorTLS.Data, injectorTLSPreviousCAKey)
+	if err := r.patchWebhookCABundle(ctx, log, falconContainer, injectorCABundle(injectorTLS)); err != nil {
+		return err
+	}
+
+	return r.Update(ctx, log, falconContainer, injectorTLS)
 }
 
-func (r *FalconContainerReconciler) newInjectorTLSSecret(c []byte, k []byte, b []byte) *corev1.Secret {
+func (r *FalconContainerReconciler) newInjectorTLSSecret(c []byte, k []byte, b []byte, falconContainer *v1beta1.FalconContainer) *corev1.Secret {
 	return &corev1.Secret{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: appsv1.SchemeGroupVersion.String(),
@@ -75,7 +215,7 @@ func (r *FalconContainerReconciler) newInjectorTLSSecret(c []byte, k []byte, b [
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      injectorTLSSecretName,
-			Namespace: r.Namespace(),
+			Namespace: falconContainer.TargetNs(),
 			Labels:    FcLabels,
 		},
 		Data: map[string][]byte{
@@ -87,7 +227,7 @@ func (r *FalconContainerReconciler) newInjectorTLSSecret(c []byte, k []byte, b [
 	}
 }
 
-func (r *FalconContainerReconciler) reconcileDeployment(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*appsv1.Deployment, error) {
+func (r *FalconContainerReconciler) reconcileDeployment(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, injectorTLS *corev1.Secret) (*appsv1.Deployment, error) {
 	update := false
 
 	imageUri, err := r.imageUri(ctx, falconContainer)
@@ -95,11 +235,14 @@ func (r *FalconContainerReconciler) reconcileDeployment(ctx context.Context, log
 		return &appsv1.Deployment{}, fmt.Errorf("unable to determine falcon container image URI: %v", err)
 	}
 
-	deployment := r.newDeployment(imageUri, falconContainer)
+	deployment := r.newDeployment(imageUri, injectorTLSHash(injectorTLS), falconContainer)
 	existingDeployment := &appsv1.Deployment{}
-	err = r.Client.Get(ctx, types.NamespacedName{Name: injectorName, Namespace: r.Namespace()}, existingDeployment)
+	err = r.Client.Get(ctx, types.NamespacedName{Name: injectorName, Namespace: falconContainer.TargetNs()}, existingDeployment)
 	if err != nil {
 		if errors.IsNotFound(err) {
+			if err = r.verifyImage(ctx, log, falconContainer, imageUri); err != nil {
+				return &appsv1.Deployment{}, err
+			}
 			if err = ctrl.SetControllerReference(falconContainer, deployment, r.Scheme); err != nil {
 				return &appsv1.Deployment{}, fmt.Errorf("unable to set controller reference on injector Deployment %s: %v", deployment.ObjectMeta.Name, err)
 			}
@@ -115,6 +258,9 @@ func (r *FalconContainerReconciler) reconcileDeployment(ctx context.Context, log
 	}
 
 	if !reflect.DeepEqual(deployment.Spec.Template.Spec.Containers[0].Image, existingDeployment.Spec.Template.Spec.Containers[0].Image) {
+		if err = r.verifyImage(ctx, log, falconContainer, imageUri); err != nil {
+			return &appsv1.Deployment{}, err
+		}
 		existingDeployment.Spec.Template.Spec.Containers[0].Image = deployment.Spec.Template.Spec.Containers[0].Image
 		update = true
 	}
@@ -144,6 +290,15 @@ func (r *FalconContainerReconciler) reconcileDeployment(ctx context.Context, log
 		update = true
 	}
 
+	// Roll the injector replicas when the serving certificate changes
+	if existingDeployment.Spec.Template.Annotations[injectorTLSHashAnnotation] != deployment.Spec.Template.Annotations[injectorTLSHashAnnotation] {
+		if existingDeployment.Spec.Template.Annotations == nil {
+			existingDeployment.Spec.Template.Annotations = make(map[string]string)
+		}
+		existingDeployment.Spec.Template.Annotations[injectorTLSHashAnnotation] = deployment.Spec.Template.Annotations[injectorTLSHashAnnotation]
+		update = true
+	}
+
 	if update {
 		return existingDeployment, r.Update(ctx, log, falconContainer, existingDeployment)
 	}
@@ -152,7 +307,7 @@ func (r *FalconContainerReconciler) reconcileDeployment(ctx context.Context, log
 
 }
 
-func (r *FalconContainerReconciler) newDeployment(imageUri string, falconContainer *v1alpha1.FalconContainer) *appsv1.Deployment {
+func (r *FalconContainerReconciler) newDeployment(imageUri string, tlsHash string, falconContainer *v1beta1.FalconContainer) *appsv1.Deployment {
 	imagePullSecrets := []corev1.LocalObjectReference{{Name: common.FalconPullSecretName}}
 	azureVolumeName := "azure-config"
 	azureVolumePath := "/run/azure.json"
@@ -161,6 +316,7 @@ func (r *FalconContainerReconciler) newDeployment(imageUri string, falconContain
 	resources := &corev1.ResourceRequirements{}
 	var rootUid int64 = 0
 	var readMode int32 = 420
+	replicas := falconContainer.InjectorReplicas()
 	runNonRoot := true
 	initRunAsNonRoot := false
 	initContainers := []corev1.Container{}
@@ -274,17 +430,20 @@ func (r *FalconContainerReconciler) newDeployment(imageUri string, falconContain
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      injectorName,
-			Namespace: r.Namespace(),
+			Namespace: falconContainer.TargetNs(),
 			Labels:    FcLabels,
 		},
 		Spec: appsv1.DeploymentSpec{
-			Replicas: falconContainer.Spec.Injector.Replicas,
+			Replicas: &replicas,
 			Selector: &metav1.LabelSelector{
 				MatchLabels: FcLabels,
 			},
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: FcLabels,
+					Annotations: map[string]string{
+						injectorTLSHashAnnotation: tlsHash,
+					},
 				},
 				Spec: corev1.PodSpec{
 					Affinity: &corev1.Affinity{
@@ -339,7 +498,7 @@ func (r *FalconContainerReconciler) newDeployment(imageUri string, falconContain
 							},
 							Ports: []corev1.ContainerPort{
 								{
-									ContainerPort: *falconContainer.Spec.Injector.ListenPort,
+									ContainerPort: falconContainer.InjectorListenPort(),
 									Name:          common.FalconServiceHTTPSName,
 									Protocol:      corev1.ProtocolTCP,
 								},
@@ -349,7 +508,7 @@ func (r *FalconContainerReconciler) newDeployment(imageUri string, falconContain
 								ProbeHandler: corev1.ProbeHandler{
 									HTTPGet: &corev1.HTTPGetAction{
 										Path:   common.FalconContainerProbePath,
-										Port:   intstr.IntOrString{IntVal: *falconContainer.Spec.Injector.ListenPort},
+										Port:   intstr.IntOrString{IntVal: falconContainer.InjectorListenPort()},
 										Scheme: corev1.URISchemeHTTPS,
 									},
 								},
@@ -363,7 +522,7 @@ func (r *FalconContainerReconciler) newDeployment(imageUri string, falconContain
 								ProbeHandler: corev1.ProbeHandler{
 									HTTPGet: &corev1.HTTPGetAction{
 										Path:   common.FalconContainerProbePath,
-										Port:   intstr.IntOrString{IntVal: *falconContainer.Spec.Injector.ListenPort},
+										Port:   intstr.IntOrString{IntVal: falconContainer.InjectorListenPort()},
 										Scheme: corev1.URISchemeHTTPS,
 									},
 								},
@@ -383,10 +542,10 @@ func (r *FalconContainerReconciler) newDeployment(imageUri string, falconContain
 	}
 }
 
-func (r *FalconContainerReconciler) injectorPodReady(ctx context.Context, falconContainer *v1alpha1.FalconContainer) (*corev1.Pod, error) {
+func (r *FalconContainerReconciler) injectorPodReady(ctx context.Context, falconContainer *v1beta1.FalconContainer) (*corev1.Pod, error) {
 	podList := &corev1.PodList{}
 	listOpts := []client.ListOption{
-		client.InNamespace(r.Namespace()),
+		client.InNamespace(falconContainer.TargetNs()),
 		client.MatchingLabels(FcLabels),
 	}
 
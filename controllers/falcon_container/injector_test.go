@@ -0,0 +1,164 @@
+package falcon
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/tls"
+	"github.com/go-logr/logr"
+	arv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// newTestReconciler returns a FalconContainerReconciler backed by a fake client holding the objects
+func newTestReconciler(t *testing.T, objs ...client.Object) *FalconContainerReconciler {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	return &FalconContainerReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		Scheme: scheme,
+	}
+}
+
+// newTestInjectorTLSSecret returns an injector TLS Secret with a certificate valid for the given number of days
+func newTestInjectorTLSSecret(t *testing.T, days int, algorithm tls.KeyAlgorithm) *corev1.Secret {
+	t.Helper()
+	c, k, b, err := tls.CertSetup("falcon-system", days, algorithm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: injectorTLSSecretName, Namespace: "falcon-system"},
+		Data:       map[string][]byte{"tls.crt": c, "tls.key": k, "ca.crt": b},
+	}
+}
+
+func TestInjectorTLSRenewalTime(t *testing.T) {
+	renewBefore := 30
+	tests := []struct {
+		name      string
+		days      int
+		algorithm tls.KeyAlgorithm
+		window    time.Duration
+		wantErr   bool
+	}{
+		{"renewal window", 3650, tls.RSA2048, 30 * 24 * time.Hour, false},
+		{"window capped at half of the lifetime", 20, tls.RSA2048, 10 * 24 * time.Hour, false},
+		{"key algorithm changed", 3650, tls.ECDSAP256, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			falconContainer := &v1beta1.FalconContainer{}
+			falconContainer.Spec.Injector.TLS.RenewBefore = &renewBefore
+			secret := newTestInjectorTLSSecret(t, tt.days, tt.algorithm)
+
+			got, err := injectorTLSRenewalTime(secret, falconContainer)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("injectorTLSRenewalTime() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			cert, err := tls.ParseCert(secret.Data["tls.crt"])
+			if err != nil {
+				t.Fatal(err)
+			}
+			if want := cert.NotAfter.Add(-tt.window); !got.Equal(want) {
+				t.Errorf("injectorTLSRenewalTime() = %v, want %v", got, want)
+			}
+		})
+	}
+
+	secret := newTestInjectorTLSSecret(t, 3650, tls.RSA2048)
+	secret.Data["tls.crt"] = []byte("not a certificate")
+	if _, err := injectorTLSRenewalTime(secret, &v1beta1.FalconContainer{}); err == nil {
+		t.Error("injectorTLSRenewalTime() expected an error for an invalid certificate")
+	}
+}
+
+func TestInjectorTLSRotation(t *testing.T) {
+	ctx := context.Background()
+	log := logr.Discard()
+	falconContainer := &v1beta1.FalconContainer{ObjectMeta: metav1.ObjectMeta{Name: "falcon-sidecar-sensor"}}
+	// A certificate with another key algorithm than requested is unusable and rotated right away
+	existing := newTestInjectorTLSSecret(t, 3650, tls.ECDSAP256)
+	previousCA := existing.Data["ca.crt"]
+	webhook := &arv1.MutatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: webhookName},
+		Webhooks:   []arv1.MutatingWebhook{{Name: webhookName, ClientConfig: arv1.WebhookClientConfig{CABundle: previousCA}}},
+	}
+	r := newTestReconciler(t, falconContainer, existing, webhook)
+
+	rotated, err := r.reconcileInjectorTLSSecret(ctx, log, falconContainer)
+	if err != nil {
+		t.Fatalf("reconcileInjectorTLSSecret() error = %v", err)
+	}
+	newCA := rotated.Data["ca.crt"]
+	if bytes.Equal(newCA, previousCA) {
+		t.Fatal("reconcileInjectorTLSSecret() did not rotate the certificate")
+	}
+	if !bytes.Equal(rotated.Data[injectorTLSPreviousCAKey], previousCA) {
+		t.Errorf("rotated Secret %s = %q, want the previous CA", injectorTLSPreviousCAKey, rotated.Data[injectorTLSPreviousCAKey])
+	}
+
+	// The webhook trusts both CAs until the injector serves the new certificate
+	if err := r.Get(ctx, types.NamespacedName{Name: webhookName}, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if want := append(append([]byte{}, newCA...), previousCA...); !bytes.Equal(webhook.Webhooks[0].ClientConfig.CABundle, want) {
+		t.Error("webhook CA bundle does not hold both the new and the previous CA during the rotation")
+	}
+
+	replicas := int32(2)
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &replicas,
+			Template: corev1.PodTemplateSpec{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{injectorTLSHashAnnotation: injectorTLSHash(rotated)}}},
+		},
+		Status: appsv1.DeploymentStatus{ObservedGeneration: 2, Replicas: 2, UpdatedReplicas: 1, ReadyReplicas: 2},
+	}
+
+	// The previous CA stays trusted while the injector rolls out
+	if err := r.completeInjectorTLSRotation(ctx, log, falconContainer, rotated, deployment); err != nil {
+		t.Fatalf("completeInjectorTLSRotation() error = %v", err)
+	}
+	if _, ok := rotated.Data[injectorTLSPreviousCAKey]; !ok {
+		t.Fatal("completeInjectorTLSRotation() removed the previous CA before the injector rolled out")
+	}
+
+	deployment.Status.UpdatedReplicas = 2
+	if err := r.completeInjectorTLSRotation(ctx, log, falconContainer, rotated, deployment); err != nil {
+		t.Fatalf("completeInjectorTLSRotation() error = %v", err)
+	}
+	secret := &corev1.Secret{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(existing), secret); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := secret.Data[injectorTLSPreviousCAKey]; ok {
+		t.Error("completeInjectorTLSRotation() kept the previous CA after the injector rolled out")
+	}
+	if err := r.Get(ctx, types.NamespacedName{Name: webhookName}, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(webhook.Webhooks[0].ClientConfig.CABundle, newCA) {
+		t.Error("webhook CA bundle still trusts the previous CA after the rotation")
+	}
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -14,22 +14,13 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-const (
-	injectorNamespace = "falcon-system"
-)
-
 var (
 	namespaceLabels = map[string]string{
 		common.FalconContainerInjection: "disabled",
-		"kubernetes.io/metadata.name":   "falcon-system",
 	}
 )
 
-func (r *FalconContainerReconciler) Namespace() string {
-	return injectorNamespace
-}
-
-func (r *FalconContainerReconciler) NamespaceLabels() map[string]string {
+func (r *FalconContainerReconciler) NamespaceLabels(falconContainer *v1beta1.FalconContainer) map[string]string {
 	nsLabels := make(map[string]string)
 	for k, v := range FcLabels {
 		nsLabels[k] = v
@@ -37,13 +28,14 @@ func (r *FalconContainerReconciler) NamespaceLabels() map[string]string {
 	for k, v := range namespaceLabels {
 		nsLabels[k] = v
 	}
+	nsLabels["kubernetes.io/metadata.name"] = falconContainer.TargetNs()
 	return nsLabels
 }
 
-func (r *FalconContainerReconciler) reconcileNamespace(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.Namespace, error) {
-	namespace := r.newNamespace()
+func (r *FalconContainerReconciler) reconcileNamespace(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.Namespace, error) {
+	namespace := r.newNamespace(falconContainer)
 	existingNamespace := &corev1.Namespace{}
-	err := r.Client.Get(ctx, types.NamespacedName{Name: r.Namespace()}, existingNamespace)
+	err := r.Client.Get(ctx, types.NamespacedName{Name: falconContainer.TargetNs()}, existingNamespace)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			if err = ctrl.SetControllerReference(falconContainer, namespace, r.Scheme); err != nil {
@@ -51,21 +43,21 @@ func (r *FalconContainerReconciler) reconcileNamespace(ctx context.Context, log
 			}
 			return namespace, r.Create(ctx, log, falconContainer, namespace)
 		}
-		return &corev1.Namespace{}, fmt.Errorf("unable to query existing namespace %s: %v", r.Namespace(), err)
+		return &corev1.Namespace{}, fmt.Errorf("unable to query existing namespace %s: %v", falconContainer.TargetNs(), err)
 	}
 
 	return existingNamespace, nil
 }
 
-func (r *FalconContainerReconciler) newNamespace() *corev1.Namespace {
+func (r *FalconContainerReconciler) newNamespace(falconContainer *v1beta1.FalconContainer) *corev1.Namespace {
 	return &corev1.Namespace{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
 			Kind:       "Namespace",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:   r.Namespace(),
-			Labels: r.NamespaceLabels(),
+			Name:   falconContainer.TargetNs(),
+			Labels: r.NamespaceLabels(falconContainer),
 		},
 	}
 }
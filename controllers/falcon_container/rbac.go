@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -21,11 +21,11 @@ const (
 	injectorClusterRoleBindingName = "falcon-operator-container-rolebinding"
 )
 
-func (r *FalconContainerReconciler) reconcileServiceAccount(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.ServiceAccount, error) {
+func (r *FalconContainerReconciler) reconcileServiceAccount(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.ServiceAccount, error) {
 	update := false
 	serviceAccount := r.newServiceAccount(falconContainer)
 	existingServiceAccount := &corev1.ServiceAccount{}
-	err := r.Client.Get(ctx, types.NamespacedName{Name: common.SidecarServiceAccountName, Namespace: r.Namespace()}, existingServiceAccount)
+	err := r.Client.Get(ctx, types.NamespacedName{Name: common.SidecarServiceAccountName, Namespace: falconContainer.TargetNs()}, existingServiceAccount)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			if err = ctrl.SetControllerReference(falconContainer, serviceAccount, r.Scheme); err != nil {
@@ -51,7 +51,7 @@ func (r *FalconContainerReconciler) reconcileServiceAccount(ctx context.Context,
 
 }
 
-func (r *FalconContainerReconciler) reconcileClusterRoleBinding(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*rbacv1.ClusterRoleBinding, error) {
+func (r *FalconContainerReconciler) reconcileClusterRoleBinding(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*rbacv1.ClusterRoleBinding, error) {
 	clusterRoleBinding := r.newClusterRoleBinding(falconContainer)
 	existingClusterRoleBinding := &rbacv1.ClusterRoleBinding{}
 	err := r.Client.Get(ctx, types.NamespacedName{Name: injectorClusterRoleBindingName}, existingClusterRoleBinding)
@@ -82,7 +82,7 @@ func (r *FalconContainerReconciler) reconcileClusterRoleBinding(ctx context.Cont
 
 }
 
-func (r *FalconContainerReconciler) newServiceAccount(falconContainer *v1alpha1.FalconContainer) *corev1.ServiceAccount {
+func (r *FalconContainerReconciler) newServiceAccount(falconContainer *v1beta1.FalconContainer) *corev1.ServiceAccount {
 	imagePullSecrets := []corev1.LocalObjectReference{{Name: common.FalconPullSecretName}}
 	if common.FalconPullSecretName != falconContainer.Spec.Injector.ImagePullSecretName {
 		imagePullSecrets = append(imagePullSecrets, corev1.LocalObjectReference{Name: falconContainer.Spec.Injector.ImagePullSecretName})
@@ -94,7 +94,7 @@ func (r *FalconContainerReconciler) newServiceAccount(falconContainer *v1alpha1.
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        common.SidecarServiceAccountName,
-			Namespace:   r.Namespace(),
+			Namespace:   falconContainer.TargetNs(),
 			Labels:      FcLabels,
 			Annotations: falconContainer.Spec.Injector.ServiceAccount.Annotations,
 		},
@@ -102,7 +102,7 @@ func (r *FalconContainerReconciler) newServiceAccount(falconContainer *v1alpha1.
 	}
 }
 
-func (r *FalconContainerReconciler) newClusterRoleBinding(falconContainer *v1alpha1.FalconContainer) *rbacv1.ClusterRoleBinding {
+func (r *FalconContainerReconciler) newClusterRoleBinding(falconContainer *v1beta1.FalconContainer) *rbacv1.ClusterRoleBinding {
 	return &rbacv1.ClusterRoleBinding{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: rbacv1.SchemeGroupVersion.String(),
@@ -115,7 +115,7 @@ func (r *FalconContainerReconciler) newClusterRoleBinding(falconContainer *v1alp
 		Subjects: []rbacv1.Subject{{
 			Kind:      "ServiceAccount",
 			Name:      common.SidecarServiceAccountName,
-			Namespace: r.Namespace(),
+			Namespace: falconContainer.TargetNs(),
 		}},
 		RoleRef: rbacv1.RoleRef{
 			APIGroup: "rbac.authorization.k8s.io",
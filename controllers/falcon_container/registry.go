@@ -2,28 +2,180 @@ package falcon
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"os"
 	"reflect"
+	"strings"
+	"sync"
+	"time"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/assets"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/crowdstrike/falcon-operator/pkg/registry/pulltoken"
+	"github.com/crowdstrike/gofalcon/falcon"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	types "k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
-func (r *FalconContainerReconciler) reconcileRegistrySecrets(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.SecretList, error) {
-	injectionEnabledValue := "enabled"
-	injectionDisabledValue := "disabled"
-	disableDefaultNSInjection := false
+// pullTokenTTL bounds how long a registry pull token fetched from the Falcon API is reused
+const pullTokenTTL = 30 * time.Minute
+
+// pullTokenCache keeps the registry pull token of each FalconContainer so that namespace events do not
+// each fetch a new token from the Falcon API
+type pullTokenCache struct {
+	mu      sync.Mutex
+	entries map[string]pullTokenCacheEntry
+	// sweeps records the pull token and FalconContainer generation of the last full sweep of all namespaces
+	sweeps map[string]pullTokenSweep
+}
+
+type pullTokenSweep struct {
+	token      string
+	generation int64
+}
+
+type pullTokenCacheEntry struct {
+	// credentials identifies the Falcon API credentials the token was issued for
+	credentials string
+	token       []byte
+	expires     time.Time
+}
+
+func (c *pullTokenCache) get(name, credentials string) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[name]
+	if !ok || entry.credentials != credentials || time.Now().After(entry.expires) {
+		return nil
+	}
+	return entry.token
+}
+
+func (c *pullTokenCache) set(name, credentials string, token []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]pullTokenCacheEntry)
+	}
+	c.entries[name] = pullTokenCacheEntry{credentials: credentials, token: token, expires: time.Now().Add(pullTokenTTL)}
+}
+
+func (c *pullTokenCache) forget(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, name)
+	delete(c.sweeps, name)
+}
+
+// swept reports whether all namespaces already received the token for this generation of the FalconContainer
+func (c *pullTokenCache) swept(name string, token []byte, generation int64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	sweep, ok := c.sweeps[name]
+	return ok && sweep.generation == generation && sweep.token == string(token)
+}
+
+func (c *pullTokenCache) setSwept(name string, token []byte, generation int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.sweeps == nil {
+		c.sweeps = make(map[string]pullTokenSweep)
+	}
+	c.sweeps[name] = pullTokenSweep{token: string(token), generation: generation}
+}
+
+// pullToken returns the registry pull token for the FalconContainer, fetching a new one from the Falcon API
+// when the cached token expired or the API credentials changed
+func (r *FalconContainerReconciler) pullToken(ctx context.Context, falconContainer *v1beta1.FalconContainer) ([]byte, error) {
+	apiConfig, err := r.falconApiConfig(ctx, falconContainer)
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := pullTokenCredentials(apiConfig)
+	if token := r.pullTokens.get(falconContainer.Name, credentials); token != nil {
+		return token, nil
+	}
+
+	token, err := pulltoken.CrowdStrike(ctx, apiConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get registry pull token: %v", err)
+	}
+
+	r.pullTokens.set(falconContainer.Name, credentials, token)
+	return token, nil
+}
+
+// pullTokenCredentials fingerprints the Falcon API credentials without keeping the client secret in memory
+func pullTokenCredentials(apiConfig *falcon.ApiConfig) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{apiConfig.ClientId, apiConfig.ClientSecret, apiConfig.MemberCID, apiConfig.Cloud.String()}, "\x00")))
+	return hex.EncodeToString(sum[:])
+}
+
+// registryPullSecretsEnabled reports whether the operator distributes the CrowdStrike registry pull token to the
+// namespaces subject to injection
+func (r *FalconContainerReconciler) registryPullSecretsEnabled(falconContainer *v1beta1.FalconContainer) bool {
+	if falconContainer.Spec.Image != nil && *falconContainer.Spec.Image != "" {
+		return false
+	}
+	if os.Getenv("RELATED_IMAGE_SIDECAR_SENSOR") != "" && falconContainer.Spec.FalconAPI == nil {
+		return false
+	}
+	return !r.imageMirroringEnabled(falconContainer)
+}
+
+// pullSecretNamespaceEligible reports whether pods in the namespace can be injected and therefore need the
+// registry pull token. The namespace is matched against the same namespace selector as the injector webhook. The
+// injector namespace is always eligible.
+func pullSecretNamespaceEligible(falconContainer *v1beta1.FalconContainer, ns *corev1.Namespace) bool {
+	if ns.Name == falconContainer.TargetNs() {
+		return true
+	}
+	if ns.Name == "kube-public" || ns.Name == "kube-system" {
+		return false
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(webhookNamespaceSelector(falconContainer.Spec.Injector.Webhook, falconContainer.Spec.Injector.DisableDefaultNSInjection))
+	if err != nil {
+		// the webhook cannot be configured with an invalid selector either, so no namespace is injected
+		return false
+	}
+
+	// the API server sets the name label on every namespace, but namespaces created before it did may lack it
+	nsLabels := labels.Set{corev1.LabelMetadataName: ns.Name}
+	for key, value := range ns.Labels {
+		nsLabels[key] = value
+	}
+	return selector.Matches(nsLabels)
+}
+
+// reconcileRegistrySecrets distributes the registry pull token to all eligible namespaces and prunes it from the others. The
+// sweep only runs on the first reconcile after the operator starts, when the pull token changes or when the FalconContainer
+// spec changes; namespace events are handled one namespace at a time by reconcileNamespaceRegistrySecret.
+func (r *FalconContainerReconciler) reconcileRegistrySecrets(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.SecretList, error) {
 	secretList := &corev1.SecretList{}
 
-	if falconContainer.Spec.Injector.DisableDefaultNSInjection {
-		disableDefaultNSInjection = falconContainer.Spec.Injector.DisableDefaultNSInjection
+	pulltoken, err := r.pullToken(ctx, falconContainer)
+	if err != nil {
+		return &corev1.SecretList{}, err
+	}
+
+	if r.pullTokens.swept(falconContainer.Name, pulltoken, falconContainer.Generation) {
+		return secretList, nil
 	}
 
 	nsList := &corev1.NamespaceList{}
@@ -31,27 +183,12 @@ func (r *FalconContainerReconciler) reconcileRegistrySecrets(ctx context.Context
 		return &corev1.SecretList{}, fmt.Errorf("unable to list current namespaces: %v", err)
 	}
 
-	pulltoken, err := pulltoken.CrowdStrike(ctx, r.falconApiConfig(ctx, falconContainer))
-	if err != nil {
-		return &corev1.SecretList{}, fmt.Errorf("unable to get registry pull token: %v", err)
-	}
-
+	ineligible := map[string]bool{}
 	for _, ns := range nsList.Items {
-		if ns.Name == "kube-public" || ns.Name == "kube-system" {
+		if !pullSecretNamespaceEligible(falconContainer, &ns) {
+			ineligible[ns.Name] = true
 			continue
 		}
-		if disableDefaultNSInjection {
-			// if default namespace injection is disabled, require that the injection label be set to enabled
-			// in both cases below, ensure that we're not blocking pull secret creation within the injector namespace
-			if (ns.Labels == nil || ns.Labels[common.FalconContainerInjection] != injectionEnabledValue) && ns.Name != r.Namespace() {
-				continue
-			}
-		} else {
-			// otherwise, just ensure the injection label is not set to disabled
-			if ns.Labels != nil && ns.Labels[common.FalconContainerInjection] == injectionDisabledValue && ns.Name != r.Namespace() {
-				continue
-			}
-		}
 
 		secret, err := r.reconcileRegistrySecret(ns.Name, pulltoken, ctx, log, falconContainer)
 		if err != nil {
@@ -61,10 +198,113 @@ func (r *FalconContainerReconciler) reconcileRegistrySecrets(ctx context.Context
 		secretList.Items = append(secretList.Items, *secret)
 	}
 
+	existingSecrets, err := r.listRegistrySecrets(ctx, falconContainer)
+	if err != nil {
+		return secretList, err
+	}
+
+	stale := []corev1.Secret{}
+	for _, secret := range existingSecrets {
+		if ineligible[secret.Namespace] {
+			stale = append(stale, secret)
+		}
+	}
+
+	if err := r.pruneRegistrySecrets(ctx, req, log, falconContainer, stale); err != nil {
+		return secretList, err
+	}
+
+	r.pullTokens.setSwept(falconContainer.Name, pulltoken, falconContainer.Generation)
 	return secretList, nil
 }
 
-func (r *FalconContainerReconciler) reconcileRegistrySecret(namespace string, pulltoken []byte, ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.Secret, error) {
+// listRegistrySecrets returns the registry pull token Secrets created by the FalconContainer across all namespaces
+func (r *FalconContainerReconciler) listRegistrySecrets(ctx context.Context, falconContainer *v1beta1.FalconContainer) ([]corev1.Secret, error) {
+	secretList := &corev1.SecretList{}
+	if err := r.Client.List(ctx, secretList, client.MatchingLabels{common.FalconInstanceKey: common.FalconPullSecretName}); err != nil {
+		return nil, fmt.Errorf("unable to list registry pull token secrets: %v", err)
+	}
+
+	secrets := []corev1.Secret{}
+	for _, secret := range secretList.Items {
+		if secret.Name == common.FalconPullSecretName && metav1.IsControlledBy(&secret, falconContainer) {
+			secrets = append(secrets, secret)
+		}
+	}
+	return secrets, nil
+}
+
+// pruneRegistrySecrets deletes registry pull token Secrets left in namespaces that opted out of injection
+// and adds them to the pruned count in the FalconContainer status
+func (r *FalconContainerReconciler) pruneRegistrySecrets(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer, secrets []corev1.Secret) error {
+	var pruned int32
+	for i := range secrets {
+		log.Info("Pruning registry pull token secret from namespace no longer subject to injection", "namespace", secrets[i].Namespace)
+		if err := r.Client.Delete(ctx, &secrets[i]); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return fmt.Errorf("unable to prune registry pull token secret in namespace %s: %v", secrets[i].Namespace, err)
+		}
+		pruned++
+	}
+
+	if pruned == 0 {
+		return nil
+	}
+
+	falconContainer.Status.PrunedPullSecrets += pruned
+	if err := r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionSecretReady, metav1.ConditionTrue, v1beta1.ReasonPullSecretsRemoved,
+		fmt.Sprintf("Pruned %d registry pull token secrets from namespaces no longer subject to injection", pruned)); err != nil {
+		return fmt.Errorf("unable to record pruned registry pull token secrets: %v", err)
+	}
+	return nil
+}
+
+// reconcileNamespaceRegistrySecret propagates the registry pull token to a single namespace after a namespace event
+func (r *FalconContainerReconciler) reconcileNamespaceRegistrySecret(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer, namespace string) error {
+	ns := &corev1.Namespace{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("unable to query namespace %s: %v", namespace, err)
+	}
+
+	// Secrets in terminating namespaces are removed along with the namespace
+	if ns.Status.Phase == corev1.NamespaceTerminating {
+		return nil
+	}
+
+	if !pullSecretNamespaceEligible(falconContainer, ns) {
+		existingSecret := corev1.Secret{}
+		err := r.Client.Get(ctx, types.NamespacedName{Name: common.FalconPullSecretName, Namespace: ns.Name}, &existingSecret)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("unable to query existing secret %s in namespace %s: %v", common.FalconPullSecretName, ns.Name, err)
+		}
+
+		if !metav1.IsControlledBy(&existingSecret, falconContainer) {
+			return nil
+		}
+		return r.pruneRegistrySecrets(ctx, req, log, falconContainer, []corev1.Secret{existingSecret})
+	}
+
+	pulltoken, err := r.pullToken(ctx, falconContainer)
+	if err != nil {
+		return err
+	}
+
+	if _, err := r.reconcileRegistrySecret(ns.Name, pulltoken, ctx, log, falconContainer); err != nil {
+		return fmt.Errorf("unable to reconcile registry secret in namespace %s: %v", ns.Name, err)
+	}
+
+	return nil
+}
+
+func (r *FalconContainerReconciler) reconcileRegistrySecret(namespace string, pulltoken []byte, ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.Secret, error) {
 	secret := assets.PullSecret(namespace, pulltoken)
 	existingSecret := &corev1.Secret{}
 	err := r.Client.Get(ctx, types.NamespacedName{Name: common.FalconPullSecretName, Namespace: namespace}, existingSecret)
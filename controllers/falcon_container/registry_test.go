@@ -0,0 +1,76 @@
+package falcon
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPullSecretNamespaceEligible(t *testing.T) {
+	tests := []struct {
+		name               string
+		namespace          string
+		labels             map[string]string
+		disableNSInjection bool
+		excludedNamespaces []string
+		namespaceSelector  *metav1.LabelSelector
+		want               bool
+	}{
+		{name: "target namespace", namespace: "falcon-system", labels: map[string]string{common.FalconContainerInjection: "disabled"}, want: true},
+		{name: "kube-system", namespace: "kube-system", want: false},
+		{name: "unlabeled namespace", namespace: "app", want: true},
+		{name: "injection disabled", namespace: "app", labels: map[string]string{common.FalconContainerInjection: "disabled"}, want: false},
+		{name: "default injection disabled", namespace: "app", disableNSInjection: true, want: false},
+		{name: "default injection disabled, namespace enabled", namespace: "app", labels: map[string]string{common.FalconContainerInjection: "enabled"}, disableNSInjection: true, want: true},
+		{name: "excluded namespace", namespace: "app", excludedNamespaces: []string{"app"}, want: false},
+		{name: "other namespace excluded", namespace: "app", excludedNamespaces: []string{"other"}, want: true},
+		{name: "control plane namespace", namespace: "app", labels: map[string]string{"control-plane": "true"}, want: false},
+		{
+			name:              "namespace selector matches",
+			namespace:         "app",
+			labels:            map[string]string{"team": "payments"},
+			namespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+			want:              true,
+		},
+		{
+			name:              "namespace selector does not match",
+			namespace:         "app",
+			labels:            map[string]string{"team": "billing"},
+			namespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+			want:              false,
+		},
+		{
+			name:      "namespace selector expression",
+			namespace: "app",
+			labels:    map[string]string{"env": "dev"},
+			namespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "env", Operator: metav1.LabelSelectorOpNotIn, Values: []string{"dev"}},
+			}},
+			want: false,
+		},
+		{
+			name:              "namespace selector does not widen injection",
+			namespace:         "app",
+			labels:            map[string]string{"team": "payments", common.FalconContainerInjection: "disabled"},
+			namespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "payments"}},
+			want:              false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			falconContainer := &v1beta1.FalconContainer{}
+			falconContainer.Spec.Injector.DisableDefaultNSInjection = tt.disableNSInjection
+			falconContainer.Spec.Injector.Webhook.ExcludedNamespaces = tt.excludedNamespaces
+			falconContainer.Spec.Injector.Webhook.NamespaceSelector = tt.namespaceSelector
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: tt.namespace, Labels: tt.labels}}
+
+			if got := pullSecretNamespaceEligible(falconContainer, ns); got != tt.want {
+				t.Errorf("pullSecretNamespaceEligible() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -16,12 +16,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-func (r *FalconContainerReconciler) reconcileService(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer) (*corev1.Service, error) {
+func (r *FalconContainerReconciler) reconcileService(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer) (*corev1.Service, error) {
 	service := r.newService(falconContainer)
 	updated := false
 	existingService := &corev1.Service{}
 
-	err := r.Client.Get(ctx, types.NamespacedName{Name: injectorName, Namespace: r.Namespace()}, existingService)
+	err := r.Client.Get(ctx, types.NamespacedName{Name: injectorName, Namespace: falconContainer.TargetNs()}, existingService)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			if err = ctrl.SetControllerReference(falconContainer, service, r.Scheme); err != nil {
@@ -52,7 +52,7 @@ func (r *FalconContainerReconciler) reconcileService(ctx context.Context, log lo
 
 }
 
-func (r *FalconContainerReconciler) newService(falconContainer *v1alpha1.FalconContainer) *corev1.Service {
+func (r *FalconContainerReconciler) newService(falconContainer *v1beta1.FalconContainer) *corev1.Service {
 	return &corev1.Service{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: corev1.SchemeGroupVersion.String(),
@@ -60,7 +60,7 @@ func (r *FalconContainerReconciler) newService(falconContainer *v1alpha1.FalconC
 		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      injectorName,
-			Namespace: r.Namespace(),
+			Namespace: falconContainer.TargetNs(),
 			Labels:    FcLabels,
 		},
 		Spec: corev1.ServiceSpec{
@@ -68,7 +68,7 @@ func (r *FalconContainerReconciler) newService(falconContainer *v1alpha1.FalconC
 			Ports: []corev1.ServicePort{
 				{
 					Name:       common.FalconServiceHTTPSName,
-					Port:       *falconContainer.Spec.Injector.ListenPort,
+					Port:       falconContainer.InjectorListenPort(),
 					Protocol:   corev1.ProtocolTCP,
 					TargetPort: intstr.FromString(common.FalconServiceHTTPSName),
 				},
@@ -0,0 +1,109 @@
+package falcon
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/tls"
+	"github.com/go-logr/logr"
+	arv1 "k8s.io/api/admissionregistration/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// reconcileDeploymentStatus reports the rollout state of the injector Deployment
+func (r *FalconContainerReconciler) reconcileDeploymentStatus(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer, deployment *appsv1.Deployment) error {
+	var replicas int32 = 1
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	falconContainer.Status.InjectorReplicas = replicas
+	falconContainer.Status.InjectorReadyReplicas = deployment.Status.ReadyReplicas
+
+	status := metav1.ConditionFalse
+	reason := v1beta1.ReasonRolloutInProgress
+	if deploymentRolledOut(deployment) {
+		status = metav1.ConditionTrue
+		reason = v1beta1.ReasonRolloutComplete
+	}
+
+	return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionDeploymentReady, status, reason,
+		fmt.Sprintf("%d of %d injector replicas ready", deployment.Status.ReadyReplicas, replicas))
+}
+
+// deploymentRolledOut reports whether every replica of the Deployment runs the latest pod template and is ready
+func deploymentRolledOut(deployment *appsv1.Deployment) bool {
+	var replicas int32 = 1
+	if deployment.Spec.Replicas != nil {
+		replicas = *deployment.Spec.Replicas
+	}
+
+	// Replica counts are only trusted once the Deployment controller has observed the latest Deployment spec
+	return deployment.Generation > 0 && deployment.Status.ObservedGeneration >= deployment.Generation &&
+		deployment.Status.UpdatedReplicas == replicas && deployment.Status.ReadyReplicas == replicas &&
+		deployment.Status.Replicas == replicas
+}
+
+// reconcileServiceStatus reports whether the injector Service has ready endpoints to route admission requests to
+func (r *FalconContainerReconciler) reconcileServiceStatus(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer, service *corev1.Service) error {
+	endpoints := &corev1.Endpoints{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: service.Name, Namespace: service.Namespace}, endpoints)
+	if err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("unable to query endpoints of injector Service %s: %v", service.Name, err)
+	}
+
+	readyAddresses := 0
+	for _, subset := range endpoints.Subsets {
+		readyAddresses += len(subset.Addresses)
+	}
+
+	if readyAddresses == 0 {
+		return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionServiceReady, metav1.ConditionFalse, v1beta1.ReasonReqNotMet,
+			fmt.Sprintf("Injector Service %s has no ready endpoints", service.Name))
+	}
+
+	return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionServiceReady, metav1.ConditionTrue, v1beta1.ReasonReqMet,
+		fmt.Sprintf("Injector Service %s has %d ready endpoint(s)", service.Name, readyAddresses))
+}
+
+// reconcileWebhookStatus reports whether the injector MutatingWebhookConfiguration trusts a valid CA and points at the injector Service
+func (r *FalconContainerReconciler) reconcileWebhookStatus(ctx context.Context, req ctrl.Request, log logr.Logger, falconContainer *v1beta1.FalconContainer, webhook *arv1.MutatingWebhookConfiguration, caBundle []byte) error {
+	if len(webhook.Webhooks) == 0 {
+		return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionWebhookReady, metav1.ConditionFalse, v1beta1.ReasonReqNotMet,
+			fmt.Sprintf("MutatingWebhookConfiguration %s has no webhooks", webhook.Name))
+	}
+
+	clientConfig := webhook.Webhooks[0].ClientConfig
+	if clientConfig.Service == nil || clientConfig.Service.Name != injectorName || clientConfig.Service.Namespace != falconContainer.TargetNs() {
+		return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionWebhookReady, metav1.ConditionFalse, v1beta1.ReasonReqNotMet,
+			fmt.Sprintf("MutatingWebhookConfiguration %s does not point to injector Service %s/%s", webhook.Name, falconContainer.TargetNs(), injectorName))
+	}
+
+	if !bytes.Equal(clientConfig.CABundle, caBundle) {
+		return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionWebhookReady, metav1.ConditionFalse, v1beta1.ReasonReqNotMet,
+			fmt.Sprintf("MutatingWebhookConfiguration %s does not trust the injector CA", webhook.Name))
+	}
+
+	notAfter, err := tls.CertNotAfter(clientConfig.CABundle)
+	if err != nil {
+		return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionWebhookReady, metav1.ConditionFalse, v1beta1.ReasonReqNotMet,
+			fmt.Sprintf("MutatingWebhookConfiguration %s CA bundle is invalid: %v", webhook.Name, err))
+	}
+
+	falconContainer.Status.WebhookCAExpiry = &metav1.Time{Time: notAfter}
+	if time.Now().After(notAfter) {
+		return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionWebhookReady, metav1.ConditionFalse, v1beta1.ReasonCertExpired,
+			fmt.Sprintf("MutatingWebhookConfiguration %s CA expired at %s", webhook.Name, notAfter.Format(time.RFC3339)))
+	}
+
+	return r.StatusUpdate(ctx, req, log, falconContainer, v1beta1.ConditionWebhookReady, metav1.ConditionTrue, v1beta1.ReasonReqMet,
+		fmt.Sprintf("MutatingWebhookConfiguration %s CA valid until %s", webhook.Name, notAfter.Format(time.RFC3339)))
+}
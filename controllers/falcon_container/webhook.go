@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"reflect"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/go-logr/logr"
 	arv1 "k8s.io/api/admissionregistration/v1"
@@ -20,7 +20,7 @@ const (
 	webhookName = "mutatingwebhook.sidecar.falcon.crowdstrike.com"
 )
 
-func (r *FalconContainerReconciler) reconcileWebhook(ctx context.Context, log logr.Logger, falconContainer *v1alpha1.FalconContainer, caBundle []byte) (*arv1.MutatingWebhookConfiguration, error) {
+func (r *FalconContainerReconciler) reconcileWebhook(ctx context.Context, log logr.Logger, falconContainer *v1beta1.FalconContainer, caBundle []byte) (*arv1.MutatingWebhookConfiguration, error) {
 	disableDefaultNSInjection := false
 
 	if falconContainer.Spec.Injector.DisableDefaultNSInjection {
@@ -43,16 +43,40 @@ func (r *FalconContainerReconciler) reconcileWebhook(ctx context.Context, log lo
 		return &arv1.MutatingWebhookConfiguration{}, fmt.Errorf("unable to query existing mutating webhook configuration %s: %v", webhookName, err)
 	}
 
-	if !reflect.DeepEqual(webhook.Webhooks[0], existingWebhook.Webhooks[0]) {
-		existingWebhook.Webhooks[0] = webhook.Webhooks[0]
+	updated := false
+	if certManagerEnabled(falconContainer) {
+		// The cert-manager CA injector owns the caBundle once the webhook exists
+		if len(existingWebhook.Webhooks) > 0 && len(existingWebhook.Webhooks[0].ClientConfig.CABundle) > 0 {
+			webhook.Webhooks[0].ClientConfig.CABundle = existingWebhook.Webhooks[0].ClientConfig.CABundle
+		}
+	}
+
+	if existingWebhook.Annotations[certManagerInjectCAAnnotation] != webhook.Annotations[certManagerInjectCAAnnotation] {
+		if webhook.Annotations[certManagerInjectCAAnnotation] == "" {
+			delete(existingWebhook.Annotations, certManagerInjectCAAnnotation)
+		} else {
+			if existingWebhook.Annotations == nil {
+				existingWebhook.Annotations = make(map[string]string)
+			}
+			existingWebhook.Annotations[certManagerInjectCAAnnotation] = webhook.Annotations[certManagerInjectCAAnnotation]
+		}
+		updated = true
+	}
 
-		return webhook, r.Update(ctx, log, falconContainer, existingWebhook)
+	if len(existingWebhook.Webhooks) == 0 || !reflect.DeepEqual(webhook.Webhooks[0], existingWebhook.Webhooks[0]) {
+		existingWebhook.Webhooks = webhook.Webhooks
+		updated = true
+	}
+
+	if updated {
+		return existingWebhook, r.Update(ctx, log, falconContainer, existingWebhook)
 	}
 
 	return existingWebhook, nil
 
 }
-func (r *FalconContainerReconciler) newWebhook(webhookName string, caBundle []byte, disableNSInjection bool, falconContainer *v1alpha1.FalconContainer) *arv1.MutatingWebhookConfiguration {
+func (r *FalconContainerReconciler) newWebhook(webhookName string, caBundle []byte, disableNSInjection bool, falconContainer *v1beta1.FalconContainer) *arv1.MutatingWebhookConfiguration {
+	webhookSpec := falconContainer.Spec.Injector.Webhook
 	sideEffects := arv1.SideEffectClassNone
 	reinvocationPolicy := arv1.NeverReinvocationPolicy
 	failurePolicy := arv1.Fail
@@ -60,12 +84,28 @@ func (r *FalconContainerReconciler) newWebhook(webhookName string, caBundle []by
 	scope := arv1.AllScopes
 	var timeoutSeconds int32 = 30
 	path := "/mutate"
-	operatorSelector := metav1.LabelSelectorOpNotIn
-	operatorValues := []string{"disabled"}
+	port := falconContainer.InjectorListenPort()
 
-	if disableNSInjection {
-		operatorSelector = metav1.LabelSelectorOpIn
-		operatorValues = []string{"enabled"}
+	if webhookSpec.ReinvocationPolicy != "" {
+		reinvocationPolicy = webhookSpec.ReinvocationPolicy
+	}
+	if webhookSpec.FailurePolicy != "" {
+		failurePolicy = webhookSpec.FailurePolicy
+	}
+	if webhookSpec.TimeoutSeconds != nil {
+		timeoutSeconds = *webhookSpec.TimeoutSeconds
+	}
+
+	namespaceSelector := webhookNamespaceSelector(webhookSpec, disableNSInjection)
+
+	objectSelector := &metav1.LabelSelector{}
+	if webhookSpec.ObjectSelector != nil {
+		objectSelector = webhookSpec.ObjectSelector
+	}
+
+	annotations := map[string]string{}
+	if certManagerEnabled(falconContainer) {
+		annotations[certManagerInjectCAAnnotation] = fmt.Sprintf("%s/%s", falconContainer.TargetNs(), certManagerCertName)
 	}
 
 	return &arv1.MutatingWebhookConfiguration{
@@ -74,9 +114,10 @@ func (r *FalconContainerReconciler) newWebhook(webhookName string, caBundle []by
 			Kind:       "MutatingWebhookConfiguration",
 		},
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      webhookName,
-			Namespace: r.Namespace(),
-			Labels:    FcLabels,
+			Name:        webhookName,
+			Namespace:   falconContainer.TargetNs(),
+			Labels:      FcLabels,
+			Annotations: annotations,
 		},
 		Webhooks: []arv1.MutatingWebhook{
 			{
@@ -85,31 +126,19 @@ func (r *FalconContainerReconciler) newWebhook(webhookName string, caBundle []by
 				SideEffects:             &sideEffects,
 				FailurePolicy:           &failurePolicy,
 				ReinvocationPolicy:      &reinvocationPolicy,
-				ObjectSelector:          &metav1.LabelSelector{},
+				ObjectSelector:          objectSelector,
 				MatchPolicy:             &matchPolicy,
 				ClientConfig: arv1.WebhookClientConfig{
 					CABundle: caBundle,
 					Service: &arv1.ServiceReference{
 						Name:      injectorName,
-						Namespace: r.Namespace(),
+						Namespace: falconContainer.TargetNs(),
 						Path:      &path,
-						Port:      falconContainer.Spec.Injector.ListenPort,
-					},
-				},
-				TimeoutSeconds: &timeoutSeconds,
-				NamespaceSelector: &metav1.LabelSelector{
-					MatchExpressions: []metav1.LabelSelectorRequirement{
-						{
-							Key:      common.FalconContainerInjection,
-							Operator: operatorSelector,
-							Values:   operatorValues,
-						},
-						{
-							Key:      "control-plane",
-							Operator: metav1.LabelSelectorOpDoesNotExist,
-						},
+						Port:      &port,
 					},
 				},
+				TimeoutSeconds:    &timeoutSeconds,
+				NamespaceSelector: namespaceSelector,
 				Rules: []arv1.RuleWithOperations{
 					{
 						Operations: []arv1.OperationType{arv1.Create},
@@ -125,3 +154,43 @@ func (r *FalconContainerReconciler) newWebhook(webhookName string, caBundle []by
 		},
 	}
 }
+
+// webhookNamespaceSelector returns the namespace selector of the injector webhook. Namespaces are injected unless they are
+// labeled to disable injection, or only when they are labeled to enable it if default namespace injection is disabled.
+// Control plane namespaces, the excluded namespaces and namespaces not matching the selector from the spec are skipped.
+func webhookNamespaceSelector(webhookSpec v1beta1.FalconContainerWebhook, disableNSInjection bool) *metav1.LabelSelector {
+	operatorSelector := metav1.LabelSelectorOpNotIn
+	operatorValues := []string{"disabled"}
+
+	if disableNSInjection {
+		operatorSelector = metav1.LabelSelectorOpIn
+		operatorValues = []string{"enabled"}
+	}
+
+	namespaceSelector := &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{
+				Key:      common.FalconContainerInjection,
+				Operator: operatorSelector,
+				Values:   operatorValues,
+			},
+			{
+				Key:      "control-plane",
+				Operator: metav1.LabelSelectorOpDoesNotExist,
+			},
+		},
+	}
+	if len(webhookSpec.ExcludedNamespaces) > 0 {
+		namespaceSelector.MatchExpressions = append(namespaceSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+			Key:      corev1.LabelMetadataName,
+			Operator: metav1.LabelSelectorOpNotIn,
+			Values:   webhookSpec.ExcludedNamespaces,
+		})
+	}
+	if webhookSpec.NamespaceSelector != nil {
+		// Requirements from the spec narrow the default selector; they never widen it
+		namespaceSelector.MatchLabels = webhookSpec.NamespaceSelector.MatchLabels
+		namespaceSelector.MatchExpressions = append(namespaceSelector.MatchExpressions, webhookSpec.NamespaceSelector.MatchExpressions...)
+	}
+	return namespaceSelector
+}
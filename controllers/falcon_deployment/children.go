@@ -0,0 +1,179 @@
+package falcon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// reconcileNodeSensor creates, updates or deletes the FalconNodeSensor of the FalconDeployment. It returns nil when the node
+// sensor is disabled.
+func (r *FalconDeploymentReconciler) reconcileNodeSensor(ctx context.Context, log logr.Logger, deployment *v1beta1.FalconDeployment) (*v1beta1.FalconNodeSensor, error) {
+	existing := &v1beta1.FalconNodeSensor{}
+	err := r.Get(ctx, types.NamespacedName{Name: deployment.Name}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to query FalconNodeSensor %s: %v", deployment.Name, err)
+	}
+	exists := err == nil
+	if exists && !metav1.IsControlledBy(existing, deployment) {
+		return nil, fmt.Errorf("FalconNodeSensor %s already exists and is not managed by FalconDeployment %s", existing.Name, deployment.Name)
+	}
+
+	if !deployment.NodeSensorEnabled() {
+		if exists {
+			if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to delete FalconNodeSensor %s: %v", existing.Name, err)
+			}
+			log.Info("Deleted FalconNodeSensor of the disabled node sensor", "FalconNodeSensor.Name", existing.Name)
+		}
+		return nil, nil
+	}
+
+	desired := &v1beta1.FalconNodeSensor{
+		ObjectMeta: metav1.ObjectMeta{Name: deployment.Name},
+		Spec:       nodeSensorSpec(deployment),
+	}
+	desired.Default()
+
+	if !exists {
+		if err := ctrl.SetControllerReference(deployment, desired, r.Scheme); err != nil {
+			return nil, fmt.Errorf("unable to set controller reference on FalconNodeSensor %s: %v", desired.Name, err)
+		}
+		if err := r.Create(ctx, desired); err != nil {
+			return nil, fmt.Errorf("unable to create FalconNodeSensor %s: %v", desired.Name, err)
+		}
+		log.Info("Created FalconNodeSensor", "FalconNodeSensor.Name", desired.Name)
+		return desired, nil
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+		return existing, nil
+	}
+	generation := existing.Generation
+	existing.Spec = desired.Spec
+	if err := r.Update(ctx, existing); err != nil {
+		return nil, fmt.Errorf("unable to update FalconNodeSensor %s: %v", existing.Name, err)
+	}
+	if existing.Generation != generation {
+		log.Info("Updated FalconNodeSensor", "FalconNodeSensor.Name", existing.Name)
+	}
+	return existing, nil
+}
+
+// reconcileFalconContainer creates, updates or deletes the FalconContainer of the FalconDeployment. It returns nil when the
+// container sensor is disabled.
+func (r *FalconDeploymentReconciler) reconcileFalconContainer(ctx context.Context, log logr.Logger, deployment *v1beta1.FalconDeployment) (*v1beta1.FalconContainer, error) {
+	existing := &v1beta1.FalconContainer{}
+	err := r.Get(ctx, types.NamespacedName{Name: deployment.Name}, existing)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to query FalconContainer %s: %v", deployment.Name, err)
+	}
+	exists := err == nil
+	if exists && !metav1.IsControlledBy(existing, deployment) {
+		return nil, fmt.Errorf("FalconContainer %s already exists and is not managed by FalconDeployment %s", existing.Name, deployment.Name)
+	}
+
+	if !deployment.ContainerSensorEnabled() {
+		if exists {
+			if err := r.Delete(ctx, existing); err != nil && !errors.IsNotFound(err) {
+				return nil, fmt.Errorf("unable to delete FalconContainer %s: %v", existing.Name, err)
+			}
+			log.Info("Deleted FalconContainer of the disabled container sensor", "FalconContainer.Name", existing.Name)
+		}
+		return nil, nil
+	}
+
+	desired := &v1beta1.FalconContainer{
+		ObjectMeta: metav1.ObjectMeta{Name: deployment.Name},
+		Spec:       containerSpec(deployment),
+	}
+	desired.Default()
+
+	if !exists {
+		if err := ctrl.SetControllerReference(deployment, desired, r.Scheme); err != nil {
+			return nil, fmt.Errorf("unable to set controller reference on FalconContainer %s: %v", desired.Name, err)
+		}
+		if err := r.Create(ctx, desired); err != nil {
+			return nil, fmt.Errorf("unable to create FalconContainer %s: %v", desired.Name, err)
+		}
+		log.Info("Created FalconContainer", "FalconContainer.Name", desired.Name)
+		return desired, nil
+	}
+
+	if equality.Semantic.DeepEqual(existing.Spec, desired.Spec) {
+		return existing, nil
+	}
+	generation := existing.Generation
+	existing.Spec = desired.Spec
+	if err := r.Update(ctx, existing); err != nil {
+		return nil, fmt.Errorf("unable to update FalconContainer %s: %v", existing.Name, err)
+	}
+	if existing.Generation != generation {
+		log.Info("Updated FalconContainer", "FalconContainer.Name", existing.Name)
+	}
+	return existing, nil
+}
+
+// nodeSensorSpec returns the FalconNodeSensor spec of the FalconDeployment
+func nodeSensorSpec(deployment *v1beta1.FalconDeployment) v1beta1.FalconNodeSensorSpec {
+	spec := deployment.Spec.DeepCopy()
+	return v1beta1.FalconNodeSensorSpec{
+		InstallNamespace:  deployment.NodeSensorNs(),
+		Node:              spec.NodeSensor.Node,
+		Falcon:            spec.Falcon,
+		FalconAPI:         spec.FalconAPI,
+		ImageVerification: spec.ImageVerification,
+		Registry:          spec.Registry,
+	}
+}
+
+// containerSpec returns the FalconContainer spec of the FalconDeployment. When the node sensor is enabled, the pods labeled
+// to run on the nodes covered by the Falcon Sensor DaemonSet and the namespace of the node sensor are excluded from injection.
+func containerSpec(deployment *v1beta1.FalconDeployment) v1beta1.FalconContainerSpec {
+	spec := deployment.Spec.DeepCopy()
+	container := v1beta1.FalconContainerSpec{
+		InstallNamespace:  deployment.ContainerSensorNs(),
+		Falcon:            spec.Falcon,
+		FalconAPI:         spec.FalconAPI,
+		Registry:          v1beta1.RegistrySpec{Type: v1beta1.RegistryTypeCrowdStrike},
+		Injector:          spec.ContainerSensor.Injector,
+		ImageVerification: spec.ImageVerification,
+		Image:             spec.ContainerSensor.Image,
+		Version:           spec.ContainerSensor.Version,
+		RefreshInterval:   spec.ContainerSensor.RefreshInterval,
+	}
+	if spec.Registry != nil {
+		container.Registry = *spec.Registry
+	}
+
+	if !deployment.NodeSensorEnabled() {
+		return container
+	}
+
+	webhook := &container.Injector.Webhook
+	if webhook.ObjectSelector == nil {
+		webhook.ObjectSelector = &metav1.LabelSelector{}
+	}
+	webhook.ObjectSelector.MatchExpressions = append(webhook.ObjectSelector.MatchExpressions, metav1.LabelSelectorRequirement{
+		Key:      common.FalconNodeSensorLabel,
+		Operator: metav1.LabelSelectorOpNotIn,
+		Values:   []string{common.FalconNodeSensorLabelValue},
+	})
+
+	nodeSensorNs := deployment.NodeSensorNs()
+	for _, ns := range webhook.ExcludedNamespaces {
+		if ns == nodeSensorNs {
+			return container
+		}
+	}
+	webhook.ExcludedNamespaces = append(webhook.ExcludedNamespaces, nodeSensorNs)
+	return container
+}
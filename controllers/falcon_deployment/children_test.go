@@ -0,0 +1,77 @@
+package falcon
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestContainerSpec(t *testing.T) {
+	disabled := false
+	deployment := &v1beta1.FalconDeployment{
+		Spec: v1beta1.FalconDeploymentSpec{
+			ContainerSensor: v1beta1.FalconDeploymentContainerSensor{
+				Injector: v1beta1.FalconContainerInjectorSpec{
+					Webhook: v1beta1.FalconContainerWebhook{ExcludedNamespaces: []string{"falcon-system"}},
+				},
+			},
+		},
+	}
+
+	spec := containerSpec(deployment)
+	if spec.InstallNamespace != "falcon-sidecar" {
+		t.Errorf("containerSpec() InstallNamespace = %s, want falcon-sidecar", spec.InstallNamespace)
+	}
+	if spec.Registry.Type != v1beta1.RegistryTypeCrowdStrike {
+		t.Errorf("containerSpec() Registry.Type = %s, want %s", spec.Registry.Type, v1beta1.RegistryTypeCrowdStrike)
+	}
+	if got := spec.Injector.Webhook.ExcludedNamespaces; len(got) != 1 {
+		t.Errorf("containerSpec() ExcludedNamespaces = %v, want the node sensor namespace once", got)
+	}
+	selector := spec.Injector.Webhook.ObjectSelector
+	if selector == nil || len(selector.MatchExpressions) != 1 {
+		t.Fatalf("containerSpec() ObjectSelector = %v, want the node sensor label excluded", selector)
+	}
+	want := metav1.LabelSelectorRequirement{Key: common.FalconNodeSensorLabel, Operator: metav1.LabelSelectorOpNotIn, Values: []string{common.FalconNodeSensorLabelValue}}
+	if got := selector.MatchExpressions[0]; got.Key != want.Key || got.Operator != want.Operator || len(got.Values) != 1 || got.Values[0] != want.Values[0] {
+		t.Errorf("containerSpec() ObjectSelector requirement = %v, want %v", got, want)
+	}
+	if deployment.Spec.ContainerSensor.Injector.Webhook.ObjectSelector != nil {
+		t.Error("containerSpec() modified the FalconDeployment")
+	}
+
+	deployment.Spec.NodeSensor.Enabled = &disabled
+	spec = containerSpec(deployment)
+	if spec.Injector.Webhook.ObjectSelector != nil {
+		t.Errorf("containerSpec() ObjectSelector = %v without node sensor, want nil", spec.Injector.Webhook.ObjectSelector)
+	}
+}
+
+func TestCoveredNodes(t *testing.T) {
+	sensorLabels := common.CRLabels("daemonset", "falcon-node-sensor", common.FalconKernelSensor)
+	cleanupLabels := common.CRLabels("cleanup", "falcon-node-sensor", common.FalconKernelSensor)
+	now := metav1.Now()
+	pod := func(node string, labels map[string]string, phase corev1.PodPhase) corev1.Pod {
+		return corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Labels: labels},
+			Spec:       corev1.PodSpec{NodeName: node},
+			Status:     corev1.PodStatus{Phase: phase},
+		}
+	}
+	terminating := pod("node-d", sensorLabels, corev1.PodRunning)
+	terminating.DeletionTimestamp = &now
+
+	got := coveredNodes([]corev1.Pod{
+		pod("node-a", sensorLabels, corev1.PodRunning),
+		pod("node-b", sensorLabels, corev1.PodPending),
+		pod("node-c", cleanupLabels, corev1.PodRunning),
+		terminating,
+		pod("", sensorLabels, corev1.PodRunning),
+	})
+	if len(got) != 1 || !got["node-a"] {
+		t.Errorf("coveredNodes() = %v, want only node-a", got)
+	}
+}
@@ -0,0 +1,155 @@
+package falcon
+
+import (
+	"context"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	clog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// FalconDeploymentReconciler reconciles a FalconDeployment object
+type FalconDeploymentReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FalconDeploymentReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1beta1.FalconDeployment{}).
+		Owns(&v1beta1.FalconNodeSensor{}).
+		Owns(&v1beta1.FalconContainer{}).
+		Watches(&source.Kind{Type: &corev1.Pod{}}, handler.EnqueueRequestsFromMapFunc(r.sensorPodRequests)).
+		Watches(&source.Kind{Type: &corev1.Node{}}, handler.EnqueueRequestsFromMapFunc(r.nodeRequests), builder.WithPredicates(predicate.LabelChangedPredicate{})).
+		Complete(r)
+}
+
+// sensorPodRequests maps a Falcon Sensor pod to the FalconDeployments whose node sensor runs it
+func (r *FalconDeploymentReconciler) sensorPodRequests(obj client.Object) []reconcile.Request {
+	if !isSensorPod(obj.GetLabels()) {
+		return nil
+	}
+
+	deployments := &v1beta1.FalconDeploymentList{}
+	if err := r.List(context.Background(), deployments); err != nil {
+		clog.Log.Error(err, "Failed to list FalconDeployments for Pod change", "Pod.Namespace", obj.GetNamespace(), "Pod.Name", obj.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, deployment := range deployments.Items {
+		if deployment.NodeSensorEnabled() && deployment.NodeSensorNs() == obj.GetNamespace() {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: deployment.Name}})
+		}
+	}
+	return requests
+}
+
+// nodeRequests maps a Node to every FalconDeployment, so that the node sensor label is restored when it is changed. Only the
+// labeled nodes are cached, so removing the label is seen as a deletion of the Node.
+func (r *FalconDeploymentReconciler) nodeRequests(obj client.Object) []reconcile.Request {
+	deployments := &v1beta1.FalconDeploymentList{}
+	if err := r.List(context.Background(), deployments); err != nil {
+		clog.Log.Error(err, "Failed to list FalconDeployments for Node change", "Node.Name", obj.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, deployment := range deployments.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: deployment.Name}})
+	}
+	return requests
+}
+
+//+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falcondeployments,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falcondeployments/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falcondeployments/finalizers,verbs=update
+//+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falconnodesensors,verbs=get;list;watch;create;update;delete
+//+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falconcontainers,verbs=get;list;watch;create;update;delete
+//+kubebuilder:rbac:groups="",resources=nodes,verbs=get;list;watch;patch
+//+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *FalconDeploymentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := clog.FromContext(ctx)
+	deployment := &v1beta1.FalconDeployment{}
+
+	if err := r.Get(ctx, req.NamespacedName, deployment); err != nil {
+		if errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
+			// Return and don't requeue
+			log.Info("FalconDeployment resource not found. Ignoring since object must be deleted")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	// Check if the FalconDeployment instance is marked to be deleted, which is
+	// indicated by the deletion timestamp being set.
+	if deployment.GetDeletionTimestamp() != nil {
+		if controllerutil.ContainsFinalizer(deployment, common.FalconFinalizer) {
+			// The FalconNodeSensor and the FalconContainer are garbage collected, only the node labels are left behind
+			if _, err := r.reconcileNodeLabels(ctx, log, nil); err != nil {
+				return ctrl.Result{}, err
+			}
+
+			// Remove common.FalconFinalizer. Once all finalizers have been
+			// removed, the object will be deleted.
+			controllerutil.RemoveFinalizer(deployment, common.FalconFinalizer)
+			if err := r.Client.Update(ctx, deployment); err != nil {
+				return ctrl.Result{}, err
+			}
+			log.Info("Removing finalizer")
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// The defaulting webhook owns the spec. FalconDeployments created before it was installed are only defaulted in memory,
+	// so the spec is never written back.
+	deployment.Default()
+
+	// Add finalizer for this CR
+	if !controllerutil.ContainsFinalizer(deployment, common.FalconFinalizer) {
+		patch := client.MergeFrom(deployment.DeepCopy())
+		controllerutil.AddFinalizer(deployment, common.FalconFinalizer)
+		if err := r.Client.Patch(ctx, deployment, patch); err != nil {
+			log.Error(err, "Unable to update finalizer")
+			return ctrl.Result{}, err
+		}
+		log.Info("Adding finalizer")
+	}
+
+	nodesensor, err := r.reconcileNodeSensor(ctx, log, deployment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	falconContainer, err := r.reconcileFalconContainer(ctx, log, deployment)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	coveredNodes, err := r.reconcileNodeLabels(ctx, log, nodesensor)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	return ctrl.Result{}, r.reconcileStatus(ctx, log, deployment, nodesensor, falconContainer, coveredNodes)
+}
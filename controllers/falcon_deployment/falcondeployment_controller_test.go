@@ -0,0 +1,112 @@
+package falcon
+
+import (
+	"context"
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestReconcileKeepsCoveredNodesOutOfInjection follows a FalconDeployment from the Falcon Sensor pod running on a node to the
+// injector webhook selector of its FalconContainer, and checks which workload pods end up with the sidecar.
+func TestReconcileKeepsCoveredNodesOutOfInjection(t *testing.T) {
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := v1beta1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	deployment := &v1beta1.FalconDeployment{ObjectMeta: metav1.ObjectMeta{Name: "falcon"}}
+	nodeSensorNs := deployment.NodeSensorNs()
+	nodeLabel := labels.Set{common.FalconNodeSensorLabel: common.FalconNodeSensorLabelValue}
+	covered := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "covered"}}
+	stale := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "stale", Labels: nodeLabel}}
+	uncovered := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "uncovered"}}
+	sensorPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "falcon-node-sensor-abcde",
+			Namespace: nodeSensorNs,
+			Labels:    common.CRLabels("daemonset", "falcon", common.FalconKernelSensor),
+		},
+		Spec:   corev1.PodSpec{NodeName: covered.Name},
+		Status: corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(deployment, covered, stale, uncovered, sensorPod).Build()
+	r := &FalconDeploymentReconciler{Client: cli, Scheme: scheme}
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: deployment.Name}}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v", err)
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := cli.List(ctx, nodes, client.MatchingLabels(nodeLabel)); err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes.Items) != 1 || nodes.Items[0].Name != covered.Name {
+		t.Fatalf("labeled nodes = %v, want only %s", nodes.Items, covered.Name)
+	}
+
+	falconContainer := &v1beta1.FalconContainer{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: deployment.Name}, falconContainer); err != nil {
+		t.Fatalf("FalconContainer not created: %v", err)
+	}
+	webhook := falconContainer.Spec.Injector.Webhook
+	injected, err := metav1.LabelSelectorAsSelector(webhook.ObjectSelector)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A workload meant for covered nodes carries the node sensor label and selects the covered nodes with it
+	pinned := labels.Set{"app": "pinned", common.FalconNodeSensorLabel: common.FalconNodeSensorLabelValue}
+	if injected.Matches(pinned) {
+		t.Errorf("workload carrying %s is injected", common.FalconNodeSensorLabel)
+	}
+	nodeSelector := labels.SelectorFromSet(nodeLabel)
+	for _, node := range []*corev1.Node{covered, uncovered} {
+		if err := cli.Get(ctx, client.ObjectKeyFromObject(node), node); err != nil {
+			t.Fatal(err)
+		}
+		if got, want := nodeSelector.Matches(labels.Set(node.Labels)), node.Name == covered.Name; got != want {
+			t.Errorf("workload nodeSelector matches node %s = %v, want %v", node.Name, got, want)
+		}
+	}
+
+	if !injected.Matches(labels.Set{"app": "other"}) {
+		t.Error("workload without the node sensor label is not injected")
+	}
+	excluded := false
+	for _, ns := range webhook.ExcludedNamespaces {
+		excluded = excluded || ns == nodeSensorNs
+	}
+	if !excluded {
+		t.Errorf("ExcludedNamespaces = %v, want the node sensor namespace %s", webhook.ExcludedNamespaces, nodeSensorNs)
+	}
+
+	// Deleting the FalconDeployment removes the node sensor label from every node
+	if err := cli.Delete(ctx, deployment); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile() error = %v after deletion", err)
+	}
+	if err := cli.List(ctx, nodes, client.MatchingLabels(nodeLabel)); err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes.Items) != 0 {
+		t.Errorf("labeled nodes = %v after deletion, want none", nodes.Items)
+	}
+}
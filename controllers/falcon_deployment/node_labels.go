@@ -0,0 +1,86 @@
+package falcon
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// isSensorPod reports whether the labels belong to a pod of the Falcon Sensor DaemonSet
+func isSensorPod(labels map[string]string) bool {
+	return labels[common.FalconComponentKey] == common.FalconKernelSensor && labels[common.FalconInstanceNameKey] == "daemonset"
+}
+
+// coveredNodes returns the nodes a Falcon Sensor DaemonSet pod is running on
+func coveredNodes(pods []corev1.Pod) map[string]bool {
+	nodes := map[string]bool{}
+	for _, pod := range pods {
+		if !isSensorPod(pod.Labels) || pod.DeletionTimestamp != nil || pod.Spec.NodeName == "" || pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		nodes[pod.Spec.NodeName] = true
+	}
+	return nodes
+}
+
+// reconcileNodeLabels sets the node sensor label on the nodes running the Falcon Sensor of the FalconNodeSensor and removes it
+// from the other nodes, so that workloads can be scheduled onto covered nodes and kept out of sidecar injection. All labels are
+// removed when nodesensor is nil. Only labeled nodes are read, so that the operator does not cache every node of the cluster;
+// the label is added to the other covered nodes with a merge patch. It returns the number of covered nodes.
+func (r *FalconDeploymentReconciler) reconcileNodeLabels(ctx context.Context, log logr.Logger, nodesensor *v1beta1.FalconNodeSensor) (int32, error) {
+	covered := map[string]bool{}
+	if nodesensor != nil {
+		pods := &corev1.PodList{}
+		if err := r.List(ctx, pods, client.InNamespace(nodesensor.Spec.InstallNamespace), client.MatchingLabels{
+			common.FalconComponentKey:    common.FalconKernelSensor,
+			common.FalconInstanceNameKey: "daemonset",
+		}); err != nil {
+			return 0, fmt.Errorf("unable to list Falcon Sensor pods in namespace %s: %v", nodesensor.Spec.InstallNamespace, err)
+		}
+		covered = coveredNodes(pods.Items)
+	}
+
+	nodes := &corev1.NodeList{}
+	if err := r.List(ctx, nodes, client.MatchingLabels{common.FalconNodeSensorLabel: common.FalconNodeSensorLabelValue}); err != nil {
+		return 0, fmt.Errorf("unable to list labeled nodes: %v", err)
+	}
+
+	labeled := map[string]bool{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		labeled[node.Name] = true
+		if covered[node.Name] {
+			continue
+		}
+
+		patch := client.MergeFrom(node.DeepCopy())
+		delete(node.Labels, common.FalconNodeSensorLabel)
+		if err := r.Patch(ctx, node, patch); err != nil && !errors.IsNotFound(err) {
+			return 0, fmt.Errorf("unable to remove the node sensor label of node %s: %v", node.Name, err)
+		}
+		log.Info("Removed node sensor label", "Node.Name", node.Name)
+	}
+
+	label := fmt.Sprintf(`{"metadata":{"labels":{%q:%q}}}`, common.FalconNodeSensorLabel, common.FalconNodeSensorLabelValue)
+	for name := range covered {
+		if labeled[name] {
+			continue
+		}
+
+		node := &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if err := r.Patch(ctx, node, client.RawPatch(types.MergePatchType, []byte(label))); err != nil && !errors.IsNotFound(err) {
+			return 0, fmt.Errorf("unable to set the node sensor label of node %s: %v", name, err)
+		}
+		log.Info("Set node sensor label", "Node.Name", name)
+	}
+
+	return int32(len(covered)), nil
+}
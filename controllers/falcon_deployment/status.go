@@ -0,0 +1,105 @@
+package falcon
+
+import (
+	"context"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/version"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// reconcileStatus summarizes the status of the FalconNodeSensor and the FalconContainer in the FalconDeployment status
+func (r *FalconDeploymentReconciler) reconcileStatus(ctx context.Context, log logr.Logger, deployment *v1beta1.FalconDeployment, nodesensor *v1beta1.FalconNodeSensor, falconContainer *v1beta1.FalconContainer, coveredNodes int32) error {
+	status := deployment.Status.DeepCopy()
+	status.Version = version.Get()
+	status.CoveredNodes = coveredNodes
+	status.NodeSensor = ""
+	status.ContainerSensor = ""
+	ready := true
+
+	if nodesensor != nil {
+		status.NodeSensor = nodesensor.Name
+		condition := nodeSensorCondition(nodesensor)
+		condition.ObservedGeneration = deployment.Generation
+		meta.SetStatusCondition(&status.Conditions, condition)
+		ready = ready && condition.Status == metav1.ConditionTrue
+	} else {
+		meta.RemoveStatusCondition(&status.Conditions, v1beta1.ConditionNodeSensorReady)
+	}
+
+	if falconContainer != nil {
+		status.ContainerSensor = falconContainer.Name
+		condition := containerSensorCondition(falconContainer)
+		condition.ObservedGeneration = deployment.Generation
+		meta.SetStatusCondition(&status.Conditions, condition)
+		ready = ready && condition.Status == metav1.ConditionTrue
+	} else {
+		meta.RemoveStatusCondition(&status.Conditions, v1beta1.ConditionContainerSensorReady)
+	}
+
+	condition := metav1.Condition{
+		Type:               v1beta1.ConditionReady,
+		Status:             metav1.ConditionTrue,
+		Reason:             v1beta1.ReasonRolloutComplete,
+		Message:            "All enabled Falcon sensors are ready",
+		ObservedGeneration: deployment.Generation,
+	}
+	if !ready {
+		condition.Status = metav1.ConditionFalse
+		condition.Reason = v1beta1.ReasonRolloutInProgress
+		condition.Message = "Waiting for the Falcon sensors to become ready"
+	}
+	meta.SetStatusCondition(&status.Conditions, condition)
+
+	if equality.Semantic.DeepEqual(*status, deployment.Status) {
+		return nil
+	}
+	deployment.Status = *status
+	if err := r.Status().Update(ctx, deployment); err != nil {
+		log.Error(err, "Failed to update FalconDeployment status")
+		return err
+	}
+	return nil
+}
+
+// nodeSensorCondition mirrors the Ready condition of the FalconNodeSensor
+func nodeSensorCondition(nodesensor *v1beta1.FalconNodeSensor) metav1.Condition {
+	ready := meta.FindStatusCondition(nodesensor.Status.Conditions, v1beta1.ConditionReady)
+	if ready == nil {
+		return metav1.Condition{
+			Type:    v1beta1.ConditionNodeSensorReady,
+			Status:  metav1.ConditionFalse,
+			Reason:  v1beta1.ReasonRolloutInProgress,
+			Message: "FalconNodeSensor " + nodesensor.Name + " is not reconciled yet",
+		}
+	}
+	return metav1.Condition{
+		Type:    v1beta1.ConditionNodeSensorReady,
+		Status:  ready.Status,
+		Reason:  ready.Reason,
+		Message: ready.Message,
+	}
+}
+
+// containerSensorCondition reports whether both the injector Deployment and the webhook of the FalconContainer are ready
+func containerSensorCondition(falconContainer *v1beta1.FalconContainer) metav1.Condition {
+	for _, conditionType := range []string{v1beta1.ConditionDeploymentReady, v1beta1.ConditionWebhookReady} {
+		if !meta.IsStatusConditionTrue(falconContainer.Status.Conditions, conditionType) {
+			return metav1.Condition{
+				Type:    v1beta1.ConditionContainerSensorReady,
+				Status:  metav1.ConditionFalse,
+				Reason:  v1beta1.ReasonRolloutInProgress,
+				Message: "FalconContainer " + falconContainer.Name + " is waiting for condition " + conditionType,
+			}
+		}
+	}
+	return metav1.Condition{
+		Type:    v1beta1.ConditionContainerSensorReady,
+		Status:  metav1.ConditionTrue,
+		Reason:  v1beta1.ReasonRolloutComplete,
+		Message: "FalconContainer " + falconContainer.Name + " is ready",
+	}
+}
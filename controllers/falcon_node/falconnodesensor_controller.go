@@ -2,17 +2,20 @@ package falcon
 
 import (
 	"context"
+	"fmt"
 	"reflect"
-	"strings"
+	"time"
 
-	falconv1alpha1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	common_assets "github.com/crowdstrike/falcon-operator/pkg/assets"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/crowdstrike/falcon-operator/pkg/k8s_utils"
 	"github.com/crowdstrike/falcon-operator/pkg/node"
 	"github.com/crowdstrike/falcon-operator/pkg/node/assets"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/verification"
 	"github.com/crowdstrike/falcon-operator/version"
 	"github.com/go-logr/logr"
+	imagev1 "github.com/openshift/api/image/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -26,7 +29,17 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	clog "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// defaultCleanupTimeout is used when the FalconNodeSensor does not set a cleanup timeout
+	defaultCleanupTimeout = 300 * time.Second
+	// cleanupRequeueInterval is how often the node cleanup progress is checked
+	cleanupRequeueInterval = 5 * time.Second
 )
 
 // FalconNodeSensorReconciler reconciles a FalconNodeSensor object
@@ -39,13 +52,31 @@ type FalconNodeSensorReconciler struct {
 // SetupWithManager sets up the controller with the Manager.
 func (r *FalconNodeSensorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&falconv1alpha1.FalconNodeSensor{}).
+		For(&falconv1beta1.FalconNodeSensor{}).
 		Owns(&corev1.ConfigMap{}).
 		Owns(&appsv1.DaemonSet{}).
 		Owns(&corev1.Secret{}).
+		Watches(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(r.falconAPISecretRequests)).
 		Complete(r)
 }
 
+// falconAPISecretRequests maps a Secret to the FalconNodeSensors reading their Falcon API credentials from it
+func (r *FalconNodeSensorReconciler) falconAPISecretRequests(obj client.Object) []reconcile.Request {
+	nodesensors := &falconv1beta1.FalconNodeSensorList{}
+	if err := r.List(context.Background(), nodesensors); err != nil {
+		clog.Log.Error(err, "Failed to list FalconNodeSensors for Secret change", "Secret.Namespace", obj.GetNamespace(), "Secret.Name", obj.GetName())
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+	for _, nodesensor := range nodesensors.Items {
+		if nodesensor.Spec.FalconAPI != nil && nodesensor.Spec.FalconAPI.ReferencesSecret(obj.GetNamespace(), obj.GetName()) {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: nodesensor.Name}})
+		}
+	}
+	return requests
+}
+
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;delete;deletecollection
 
 //+kubebuilder:rbac:groups=falcon.crowdstrike.com,resources=falconnodesensors,verbs=get;list;watch;create;update;patch;delete
@@ -54,10 +85,11 @@ func (r *FalconNodeSensorReconciler) SetupWithManager(mgr ctrl.Manager) error {
 //+kubebuilder:rbac:groups=apps,resources=daemonsets,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=coordination.k8s.io,resources=leases,verbs=get;list;create;update
-//+kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;create;update
-//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
-//+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=clusterrolebindings,verbs=get;list;watch;create
+//+kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;create;update;delete
+//+kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch;create;delete
+//+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=clusterrolebindings,verbs=get;list;watch;create;update
 //+kubebuilder:rbac:groups="security.openshift.io",resources=securitycontextconstraints,resourceNames=privileged,verbs=use
+//+kubebuilder:rbac:groups=image.openshift.io,resources=imagestreams,verbs=get;list;watch;create;update;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -70,7 +102,7 @@ func (r *FalconNodeSensorReconciler) Reconcile(ctx context.Context, req ctrl.Req
 	logger.Info("reconciling FalconNodeSensor")
 
 	// Fetch the FalconNodeSensor instance.
-	nodesensor := &falconv1alpha1.FalconNodeSensor{}
+	nodesensor := &falconv1beta1.FalconNodeSensor{}
 
 	err := r.Get(ctx, req.NamespacedName, nodesensor)
 	if err != nil {
@@ -86,11 +118,53 @@ func (r *FalconNodeSensorReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
-	dsCondition := meta.FindStatusCondition(nodesensor.Status.Conditions, falconv1alpha1.ConditionSuccess)
+	// Check if the FalconNodeSensor instance is marked to be deleted, which is
+	// indicated by the deletion timestamp being set. The cleanup only talks to the
+	// Kubernetes API, so that it neither depends on the Falcon API nor on the registry.
+	isDSMarkedToBeDeleted := nodesensor.GetDeletionTimestamp() != nil
+	if isDSMarkedToBeDeleted {
+		if controllerutil.ContainsFinalizer(nodesensor, common.FalconFinalizer) {
+			// Allows the cleanup to be disabled by disableCleanup option
+			disableCleanup := nodesensor.Spec.Node.DisableCleanup != nil && *nodesensor.Spec.Node.DisableCleanup
+			if !disableCleanup {
+				// Run finalization logic for common.FalconFinalizer. If the
+				// finalization logic fails, don't remove the finalizer so
+				// that we can retry during the next reconciliation.
+				done, err := r.finalizeDaemonset(ctx, common.NodeServiceAccountName, nodesensor, logger)
+				if err != nil {
+					return ctrl.Result{}, err
+				}
+				// The cleanup is still running on the nodes, check back later
+				if !done {
+					return ctrl.Result{RequeueAfter: cleanupRequeueInterval}, nil
+				}
+				logger.Info("Successfully finalized daemonset")
+			} else {
+				logger.Info("Skipping cleanup because it is disabled", "disableCleanup", disableCleanup)
+			}
+
+			// Remove common.FalconFinalizer. Once all finalizers have been
+			// removed, the object will be deleted.
+			controllerutil.RemoveFinalizer(nodesensor, common.FalconFinalizer)
+			err := r.Update(ctx, nodesensor)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			log.Info("Removing finalizer")
+
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// The defaulting webhook owns the spec. FalconNodeSensors created before it was installed are only defaulted in memory,
+	// so the spec is never written back.
+	nodesensor.Default()
+
+	dsCondition := meta.FindStatusCondition(nodesensor.Status.Conditions, falconv1beta1.ConditionSuccess)
 	if dsCondition == nil {
-		err = r.conditionsUpdate(falconv1alpha1.ConditionPending,
+		err = r.conditionsUpdate(falconv1beta1.ConditionPending,
 			metav1.ConditionFalse,
-			falconv1alpha1.ReasonReqNotMet,
+			falconv1beta1.ReasonReqNotMet,
 			"FalconNodeSensor progressing",
 			ctx, nodesensor, logger)
 		if err != nil {
@@ -132,16 +206,16 @@ func (r *FalconNodeSensorReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 	}
 
-	config, err := node.NewConfigCache(ctx, logger, nodesensor)
+	config, err := node.NewConfigCache(ctx, logger, r.Client, nodesensor)
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
 	sensorConf, updated, err := r.handleConfigMaps(ctx, config, nodesensor, logger)
 	if err != nil {
-		err = r.conditionsUpdate(falconv1alpha1.ConditionFailed,
+		err = r.conditionsUpdate(falconv1beta1.ConditionFailed,
 			metav1.ConditionFalse,
-			falconv1alpha1.ReasonInstallFailed,
+			falconv1beta1.ReasonInstallFailed,
 			"FalconNodeSensor ConfigMap failed to be installed",
 			ctx, nodesensor, logger)
 		if err != nil {
@@ -152,9 +226,9 @@ func (r *FalconNodeSensorReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 	if sensorConf == nil {
-		err = r.conditionsUpdate(falconv1alpha1.ConditionConfigMapReady,
+		err = r.conditionsUpdate(falconv1beta1.ConditionConfigMapReady,
 			metav1.ConditionTrue,
-			falconv1alpha1.ReasonInstallSucceeded,
+			falconv1beta1.ReasonInstallSucceeded,
 			"FalconNodeSensor ConfigMap has been successfully created",
 			ctx, nodesensor, logger)
 		if err != nil {
@@ -166,9 +240,9 @@ func (r *FalconNodeSensorReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{Requeue: true}, nil
 	}
 	if updated {
-		err = r.conditionsUpdate(falconv1alpha1.ConditionConfigMapReady,
+		err = r.conditionsUpdate(falconv1beta1.ConditionConfigMapReady,
 			metav1.ConditionTrue,
-			falconv1alpha1.ReasonUpdateSucceeded,
+			falconv1beta1.ReasonUpdateSucceeded,
 			"FalconNodeSensor ConfigMap has been successfully updated",
 
 			ctx, nodesensor, logger)
@@ -184,142 +258,73 @@ func (r *FalconNodeSensorReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return ctrl.Result{}, err
 	}
 
-	image, err := config.GetImageURI(ctx, logger)
+	statusUpdated, requeueAfter, err := config.ApplyUpdatePolicy(ctx, logger, time.Now())
 	if err != nil {
 		return ctrl.Result{}, err
 	}
-
-	// Check if the daemonset already exists, if not create a new one
-	daemonset := &appsv1.DaemonSet{}
-
-	err = r.Get(ctx, types.NamespacedName{Name: nodesensor.Name, Namespace: nodesensor.TargetNs()}, daemonset)
-	if err != nil && errors.IsNotFound(err) {
-		// Define a new daemonset
-		ds := r.nodeSensorDaemonset(nodesensor.Name, image, serviceAccount, nodesensor, logger)
-
-		err = r.Create(ctx, ds)
+	if statusUpdated {
+		err = r.Status().Update(ctx, nodesensor)
 		if err != nil {
-			err = r.conditionsUpdate(falconv1alpha1.ConditionFailed,
-				metav1.ConditionFalse,
-				falconv1alpha1.ReasonInstallFailed,
-				"FalconNodeSensor DaemonSet failed to be installed",
-				ctx, nodesensor, logger)
-			logger.Error(err, "Failed to create new DaemonSet", "DaemonSet.Namespace", ds.Namespace, "DaemonSet.Name", ds.Name)
+			logger.Error(err, "Failed to update FalconNodeSensor status for the pending sensor version")
 			return ctrl.Result{}, err
 		}
+	}
 
-		err = r.conditionsUpdate(falconv1alpha1.ConditionDaemonSetReady,
-			metav1.ConditionTrue,
-			falconv1alpha1.ReasonInstallSucceeded,
-			"FalconNodeSensor DaemonSet has been successfully installed",
-			ctx, nodesensor, logger)
+	images, err := config.GetSensorImages(ctx, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	images, err = r.handleImageMirror(ctx, config, images, nodesensor, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	err = r.handlePreviousNamespaces(ctx, nodesensor, logger)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// One DaemonSet deploys the sensor to the nodes of each architecture
+	daemonsets := make([]*appsv1.DaemonSet, 0, len(images))
+	created = false
+	for _, sensorImage := range images {
+		daemonset, dsCreated, err := r.handleDaemonSet(ctx, config, sensorImage, serviceAccount, updated, nodesensor, logger)
 		if err != nil {
 			return ctrl.Result{}, err
 		}
-
-		logger.Info("Created a new DaemonSet", "DaemonSet.Namespace", ds.Namespace, "DaemonSet.Name", ds.Name)
+		created = created || dsCreated
+		daemonsets = append(daemonsets, daemonset)
+	}
+	if created {
 		// Daemonset created successfully - return and requeue
 		return ctrl.Result{Requeue: true}, nil
+	}
 
-	} else if err != nil {
-		logger.Error(err, "error getting DaemonSet")
+	err = r.handleRemovedArchitectures(ctx, images, nodesensor, logger)
+	if err != nil {
 		return ctrl.Result{}, err
-	} else {
-		// Copy Daemonset for updates
-		dsUpdate := daemonset.DeepCopy()
-		dsTarget := assets.Daemonset(dsUpdate.Name, image, serviceAccount, nodesensor)
-
-		// Objects to check for updates to re-spin pods
-		imgUpdate := updateDaemonSetImages(dsUpdate, image, nodesensor, logger)
-		tolsUpdate := updateDaemonSetTolerations(dsUpdate, nodesensor, logger)
-		affUpdate := updateDaemonSetAffinity(dsUpdate, dsTarget, nodesensor, logger)
-		containerVolUpdate := updateDaemonSetContainerVolumes(dsUpdate, dsTarget, logger)
-		volumeUpdates := updateDaemonSetVolumes(dsUpdate, dsTarget, logger)
-
-		// Update the daemonset and re-spin pods with changes
-		if imgUpdate || tolsUpdate || affUpdate || containerVolUpdate || volumeUpdates || updated {
-			err = r.Update(ctx, dsUpdate)
-			if err != nil {
-				err = r.conditionsUpdate(falconv1alpha1.ConditionDaemonSetReady,
-					metav1.ConditionTrue,
-					falconv1alpha1.ReasonUpdateFailed,
-					"FalconNodeSensor DaemonSet update has failed",
-					ctx, nodesensor, logger)
-				logger.Error(err, "Failed to update DaemonSet", "DaemonSet.Namespace", dsUpdate.Namespace, "DaemonSet.Name", dsUpdate.Name)
-				return ctrl.Result{}, err
-			}
-
-			err := k8s_utils.RestartDaemonSet(ctx, r.Client, dsUpdate)
-			if err != nil {
-				logger.Error(err, "Failed to restart pods after DaemonSet configuration changed.")
-				return ctrl.Result{}, err
-			}
-
-			err = r.conditionsUpdate(falconv1alpha1.ConditionDaemonSetReady,
-				metav1.ConditionTrue,
-				falconv1alpha1.ReasonUpdateSucceeded,
-				"FalconNodeSensor DaemonSet has been successfully updated",
-				ctx, nodesensor, logger)
-			if err != nil {
-				return ctrl.Result{}, err
-			}
-			logger.Info("FalconNodeSensor DaemonSet configuration changed. Pods have been restarted.")
-		}
 	}
 
-	if nodesensor.Status.Sensor == nil {
-		nodesensor.Status.Sensor = &strings.Split(image, ":")[1]
-		err = r.Status().Update(ctx, nodesensor)
-		if err != nil {
-			log.Error(err, "Failed to update FalconNodeSensor status for nodesensor.Status.Sensor")
-			return ctrl.Result{}, err
-		}
+	err = r.handleDaemonSetStatus(ctx, images, daemonsets, nodesensor, logger)
+	if err != nil {
+		return ctrl.Result{}, err
 	}
 
-	err = r.conditionsUpdate(falconv1alpha1.ConditionSuccess,
+	err = r.conditionsUpdate(falconv1beta1.ConditionSuccess,
 		metav1.ConditionTrue,
-		falconv1alpha1.ReasonInstallSucceeded,
+		falconv1beta1.ReasonInstallSucceeded,
 		"FalconNodeSensor installation completed",
 		ctx, nodesensor, logger)
 	if err != nil {
 		return ctrl.Result{Requeue: true}, err
 	}
 
-	// Check if the FalconNodeSensor instance is marked to be deleted, which is
-	// indicated by the deletion timestamp being set.
-	isDSMarkedToBeDeleted := nodesensor.GetDeletionTimestamp() != nil
-	if isDSMarkedToBeDeleted {
-		if controllerutil.ContainsFinalizer(nodesensor, common.FalconFinalizer) {
-			logger.Info("Successfully finalized daemonset")
-			// Allows the cleanup to be disabled by disableCleanup option
-			if !*nodesensor.Spec.Node.NodeCleanup {
-				// Run finalization logic for common.FalconFinalizer. If the
-				// finalization logic fails, don't remove the finalizer so
-				// that we can retry during the next reconciliation.
-				if err := r.finalizeDaemonset(ctx, image, serviceAccount, nodesensor, logger); err != nil {
-					return ctrl.Result{}, err
-				}
-			} else {
-				logger.Info("Skipping cleanup because it is disabled", "disableCleanup", *nodesensor.Spec.Node.NodeCleanup)
-			}
-
-			// Remove common.FalconFinalizer. Once all finalizers have been
-			// removed, the object will be deleted.
-			controllerutil.RemoveFinalizer(nodesensor, common.FalconFinalizer)
-			err := r.Update(ctx, nodesensor)
-			if err != nil {
-				return ctrl.Result{}, err
-			}
-			log.Info("Removing finalizer")
-
-		}
-		return ctrl.Result{}, nil
-	}
-
 	// Add finalizer for this CR
 	if !controllerutil.ContainsFinalizer(nodesensor, common.FalconFinalizer) {
+		patch := client.MergeFrom(nodesensor.DeepCopy())
 		controllerutil.AddFinalizer(nodesensor, common.FalconFinalizer)
-		err = r.Update(ctx, nodesensor)
+		err = r.Patch(ctx, nodesensor, patch)
 		if err != nil {
 			logger.Error(err, "Unable to update finalizer")
 			return ctrl.Result{}, err
@@ -328,11 +333,12 @@ func (r *FalconNodeSensorReconciler) Reconcile(ctx context.Context, req ctrl.Req
 
 	}
 
-	return ctrl.Result{}, nil
+	// The update policy checks the registry for new releases on a schedule
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 // handleNamespace creates and updates the namespace
-func (r *FalconNodeSensorReconciler) handleNamespace(ctx context.Context, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) (bool, error) {
+func (r *FalconNodeSensorReconciler) handleNamespace(ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (bool, error) {
 	ns := corev1.Namespace{}
 	err := r.Client.Get(ctx, types.NamespacedName{Name: nodesensor.TargetNs()}, &ns)
 	if err == nil || (err != nil && !errors.IsNotFound(err)) {
@@ -361,7 +367,7 @@ func (r *FalconNodeSensorReconciler) handleNamespace(ctx context.Context, nodese
 }
 
 // handleConfigMaps creates and updates the node sensor configmap
-func (r *FalconNodeSensorReconciler) handleConfigMaps(ctx context.Context, config *node.ConfigCache, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) (*corev1.ConfigMap, bool, error) {
+func (r *FalconNodeSensorReconciler) handleConfigMaps(ctx context.Context, config *node.ConfigCache, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (*corev1.ConfigMap, bool, error) {
 	var updated bool
 	cmName := nodesensor.Name + "-config"
 	confCm := &corev1.ConfigMap{}
@@ -414,9 +420,9 @@ func (r *FalconNodeSensorReconciler) handleConfigMaps(ctx context.Context, confi
 	return confCm, updated, nil
 }
 
-// handleCrowdStrikeSecrets creates and updates the image pull secrets for the nodesensor
-func (r *FalconNodeSensorReconciler) handleCrowdStrikeSecrets(ctx context.Context, config *node.ConfigCache, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) error {
-	if !config.UsingCrowdStrikeRegistry() {
+// handleCrowdStrikeSecrets creates and updates the image pull secrets for the nodesensor. Mirrored images do not need them.
+func (r *FalconNodeSensorReconciler) handleCrowdStrikeSecrets(ctx context.Context, config *node.ConfigCache, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	if !config.UsingCrowdStrikeRegistry() || config.ImageMirroringEnabled() {
 		return nil
 	}
 	secret := corev1.Secret{}
@@ -447,7 +453,196 @@ func (r *FalconNodeSensorReconciler) handleCrowdStrikeSecrets(ctx context.Contex
 	return nil
 }
 
-func (r *FalconNodeSensorReconciler) nodeSensorConfigmap(name string, config *node.ConfigCache, nodesensor *falconv1alpha1.FalconNodeSensor) (*corev1.ConfigMap, error) {
+// handleImageMirror mirrors the sensor images to the registry of spec.registry, creating the ImageStream first on OpenShift,
+// and records the outcome in the ImageReady condition. It returns the images the DaemonSets deploy.
+func (r *FalconNodeSensorReconciler) handleImageMirror(ctx context.Context, config *node.ConfigCache, images []node.SensorImage, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) ([]node.SensorImage, error) {
+	if !config.ImageMirroringEnabled() {
+		return images, nil
+	}
+
+	if nodesensor.Spec.Registry.Type == falconv1beta1.RegistryTypeOpenshift {
+		if err := r.handleImageStream(ctx, config, nodesensor, logger); err != nil {
+			return nil, err
+		}
+	}
+
+	mirrored, err := config.MirrorImages(ctx, logger, images)
+	if err != nil {
+		logger.Error(err, "Failed to mirror the Falcon Sensor image")
+		updateErr := r.conditionsUpdate(falconv1beta1.ConditionImageReady,
+			metav1.ConditionFalse,
+			falconv1beta1.ReasonFailed,
+			err.Error(),
+			ctx, nodesensor, logger)
+		if updateErr != nil {
+			return nil, updateErr
+		}
+		return nil, err
+	}
+
+	return mirrored, r.conditionsUpdate(falconv1beta1.ConditionImageReady,
+		metav1.ConditionTrue,
+		"Pushed",
+		mirrored[0].URI,
+		ctx, nodesensor, logger)
+}
+
+// handleImageStream creates the ImageStream the sensor image is pushed to on the OpenShift on-cluster registry
+func (r *FalconNodeSensorReconciler) handleImageStream(ctx context.Context, config *node.ConfigCache, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	imageStream := &imagev1.ImageStream{}
+	err := r.Get(ctx, types.NamespacedName{Name: node.ImageStreamName, Namespace: config.ImageNamespace()}, imageStream)
+	if err == nil || !errors.IsNotFound(err) {
+		return err
+	}
+
+	imageStream = &imagev1.ImageStream{
+		TypeMeta:   metav1.TypeMeta{APIVersion: imagev1.SchemeGroupVersion.String(), Kind: "ImageStream"},
+		ObjectMeta: metav1.ObjectMeta{Name: node.ImageStreamName, Namespace: config.ImageNamespace()},
+	}
+	if err = ctrl.SetControllerReference(nodesensor, imageStream, r.Scheme); err != nil {
+		return fmt.Errorf("unable to set controller reference on image stream %s: %v", node.ImageStreamName, err)
+	}
+
+	err = r.Create(ctx, imageStream)
+	if err != nil && !errors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to create new ImageStream", "ImageStream.Namespace", imageStream.Namespace, "ImageStream.Name", imageStream.Name)
+		return err
+	}
+	logger.Info("Created a new ImageStream", "ImageStream.Namespace", imageStream.Namespace, "ImageStream.Name", imageStream.Name)
+	return nil
+}
+
+// handleDaemonSet creates and updates the DaemonSet deploying the sensor image to the nodes of its architecture. It reports
+// whether the DaemonSet was just created.
+func (r *FalconNodeSensorReconciler) handleDaemonSet(ctx context.Context, config *node.ConfigCache, sensorImage node.SensorImage, serviceAccount string, configUpdated bool, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (*appsv1.DaemonSet, bool, error) {
+	dsName := node.DaemonSetName(nodesensor, sensorImage.Architecture)
+	image := sensorImage.URI
+
+	// Check if the daemonset already exists, if not create a new one
+	daemonset := &appsv1.DaemonSet{}
+
+	err := r.Get(ctx, types.NamespacedName{Name: dsName, Namespace: nodesensor.TargetNs()}, daemonset)
+	if err != nil && errors.IsNotFound(err) {
+		// Define a new daemonset
+		ds := r.nodeSensorDaemonset(dsName, image, sensorImage.Architecture, serviceAccount, nodesensor, logger)
+
+		err = r.verifyImage(ctx, config, image, nodesensor, logger)
+		if err != nil {
+			return nil, false, err
+		}
+
+		err = r.Create(ctx, ds)
+		if err != nil {
+			err = r.conditionsUpdate(falconv1beta1.ConditionFailed,
+				metav1.ConditionFalse,
+				falconv1beta1.ReasonInstallFailed,
+				"FalconNodeSensor DaemonSet failed to be installed",
+				ctx, nodesensor, logger)
+			logger.Error(err, "Failed to create new DaemonSet", "DaemonSet.Namespace", ds.Namespace, "DaemonSet.Name", ds.Name)
+			return nil, false, err
+		}
+
+		err = r.conditionsUpdate(falconv1beta1.ConditionDaemonSetReady,
+			metav1.ConditionTrue,
+			falconv1beta1.ReasonInstallSucceeded,
+			"FalconNodeSensor DaemonSet has been successfully installed",
+			ctx, nodesensor, logger)
+		if err != nil {
+			return nil, false, err
+		}
+
+		logger.Info("Created a new DaemonSet", "DaemonSet.Namespace", ds.Namespace, "DaemonSet.Name", ds.Name, "Architecture", sensorImage.Architecture)
+		return ds, true, nil
+
+	} else if err != nil {
+		logger.Error(err, "error getting DaemonSet")
+		return nil, false, err
+	}
+
+	// Copy Daemonset for updates
+	dsUpdate := daemonset.DeepCopy()
+	dsTarget := assets.Daemonset(dsUpdate.Name, image, sensorImage.Architecture, serviceAccount, nodesensor)
+
+	// Objects to check for updates to re-spin pods
+	imgUpdate := updateDaemonSetImages(dsUpdate, image, nodesensor, logger)
+	tolsUpdate := updateDaemonSetTolerations(dsUpdate, nodesensor, logger)
+	affUpdate := updateDaemonSetAffinity(dsUpdate, dsTarget, nodesensor, logger)
+	selectorUpdate := updateDaemonSetNodeSelector(dsUpdate, dsTarget, logger)
+	containerVolUpdate := updateDaemonSetContainerVolumes(dsUpdate, dsTarget, logger)
+	volumeUpdates := updateDaemonSetVolumes(dsUpdate, dsTarget, logger)
+
+	if imgUpdate {
+		err = r.verifyImage(ctx, config, image, nodesensor, logger)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	// Update the daemonset and re-spin pods with changes
+	if imgUpdate || tolsUpdate || affUpdate || selectorUpdate || containerVolUpdate || volumeUpdates || configUpdated {
+		err = r.Update(ctx, dsUpdate)
+		if err != nil {
+			err = r.conditionsUpdate(falconv1beta1.ConditionDaemonSetReady,
+				metav1.ConditionTrue,
+				falconv1beta1.ReasonUpdateFailed,
+				"FalconNodeSensor DaemonSet update has failed",
+				ctx, nodesensor, logger)
+			logger.Error(err, "Failed to update DaemonSet", "DaemonSet.Namespace", dsUpdate.Namespace, "DaemonSet.Name", dsUpdate.Name)
+			return nil, false, err
+		}
+
+		err := k8s_utils.RestartDaemonSet(ctx, r.Client, dsUpdate)
+		if err != nil {
+			logger.Error(err, "Failed to restart pods after DaemonSet configuration changed.")
+			return nil, false, err
+		}
+
+		err = r.conditionsUpdate(falconv1beta1.ConditionDaemonSetReady,
+			metav1.ConditionTrue,
+			falconv1beta1.ReasonUpdateSucceeded,
+			"FalconNodeSensor DaemonSet has been successfully updated",
+			ctx, nodesensor, logger)
+		if err != nil {
+			return nil, false, err
+		}
+		logger.Info("FalconNodeSensor DaemonSet configuration changed. Pods have been restarted.", "DaemonSet.Name", dsUpdate.Name)
+	}
+
+	return daemonset, false, nil
+}
+
+// handleRemovedArchitectures deletes the DaemonSets of node architectures that were removed from the FalconNodeSensor
+func (r *FalconNodeSensorReconciler) handleRemovedArchitectures(ctx context.Context, images []node.SensorImage, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	desired := map[string]bool{}
+	for _, sensorImage := range images {
+		desired[node.DaemonSetName(nodesensor, sensorImage.Architecture)] = true
+	}
+
+	dsList := &appsv1.DaemonSetList{}
+	if err := r.List(ctx, dsList, &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{common.FalconComponentKey: common.FalconKernelSensor, common.FalconInstanceNameKey: "daemonset"}),
+		Namespace:     nodesensor.TargetNs(),
+	}); err != nil {
+		return err
+	}
+
+	for i := range dsList.Items {
+		ds := &dsList.Items[i]
+		if desired[ds.Name] || !metav1.IsControlledBy(ds, nodesensor) {
+			continue
+		}
+
+		logger.Info("Node architecture removed from FalconNodeSensor. Deleting its DaemonSet", "DaemonSet.Namespace", ds.Namespace, "DaemonSet.Name", ds.Name)
+		if err := r.Delete(ctx, ds); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete DaemonSet", "DaemonSet.Namespace", ds.Namespace, "DaemonSet.Name", ds.Name)
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *FalconNodeSensorReconciler) nodeSensorConfigmap(name string, config *node.ConfigCache, nodesensor *falconv1beta1.FalconNodeSensor) (*corev1.ConfigMap, error) {
 	cm := assets.DaemonsetConfigMap(name, nodesensor.TargetNs(), config)
 
 	err := controllerutil.SetControllerReference(nodesensor, cm, r.Scheme)
@@ -457,8 +652,8 @@ func (r *FalconNodeSensorReconciler) nodeSensorConfigmap(name string, config *no
 	return cm, nil
 }
 
-func (r *FalconNodeSensorReconciler) nodeSensorDaemonset(name, image, serviceAccount string, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) *appsv1.DaemonSet {
-	ds := assets.Daemonset(name, image, serviceAccount, nodesensor)
+func (r *FalconNodeSensorReconciler) nodeSensorDaemonset(name, image, arch, serviceAccount string, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) *appsv1.DaemonSet {
+	ds := assets.Daemonset(name, image, arch, serviceAccount, nodesensor)
 
 	// NOTE: calling SetControllerReference, and setting owner references in
 	// general, is important as it allows deleted objects to be garbage collected.
@@ -471,7 +666,7 @@ func (r *FalconNodeSensorReconciler) nodeSensorDaemonset(name, image, serviceAcc
 }
 
 // If an update is needed, this will update the tolerations from the given DaemonSet
-func updateDaemonSetTolerations(ds *appsv1.DaemonSet, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) bool {
+func updateDaemonSetTolerations(ds *appsv1.DaemonSet, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) bool {
 	tolerations := &ds.Spec.Template.Spec.Tolerations
 	origTolerations := nodesensor.Spec.Node.Tolerations
 	tolerationsUpdate := !reflect.DeepEqual(*tolerations, origTolerations)
@@ -483,7 +678,7 @@ func updateDaemonSetTolerations(ds *appsv1.DaemonSet, nodesensor *falconv1alpha1
 }
 
 // If an update is needed, this will update the affinity from the given DaemonSet
-func updateDaemonSetAffinity(ds, origDS *appsv1.DaemonSet, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) bool {
+func updateDaemonSetAffinity(ds, origDS *appsv1.DaemonSet, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) bool {
 	nodeAffinity := ds.Spec.Template.Spec.Affinity
 	origNodeAffinity := corev1.Affinity{NodeAffinity: &nodesensor.Spec.Node.NodeAffinity}
 	affinityUpdate := !equality.Semantic.DeepEqual(nodeAffinity.NodeAffinity, origNodeAffinity.NodeAffinity)
@@ -494,6 +689,18 @@ func updateDaemonSetAffinity(ds, origDS *appsv1.DaemonSet, nodesensor *falconv1a
 	return affinityUpdate
 }
 
+// If an update is needed, this will update the node selector from the given DaemonSet
+func updateDaemonSetNodeSelector(ds, origDS *appsv1.DaemonSet, logger logr.Logger) bool {
+	nodeSelector := &ds.Spec.Template.Spec.NodeSelector
+	nodeSelectorUpdate := !reflect.DeepEqual(*nodeSelector, origDS.Spec.Template.Spec.NodeSelector)
+	if nodeSelectorUpdate {
+		logger.Info("Updating FalconNodeSensor DaemonSet NodeSelector")
+		*nodeSelector = origDS.Spec.Template.Spec.NodeSelector
+	}
+
+	return nodeSelectorUpdate
+}
+
 // If an update is needed, this will update the containervolumes from the given DaemonSet
 func updateDaemonSetContainerVolumes(ds, origDS *appsv1.DaemonSet, logger logr.Logger) bool {
 	containerVolumeMounts := &ds.Spec.Template.Spec.Containers[0].VolumeMounts
@@ -519,7 +726,7 @@ func updateDaemonSetVolumes(ds, origDS *appsv1.DaemonSet, logger logr.Logger) bo
 }
 
 // If an update is needed, this will update the InitContainer image reference from the given DaemonSet
-func updateDaemonSetImages(ds *appsv1.DaemonSet, origImg string, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) bool {
+func updateDaemonSetImages(ds *appsv1.DaemonSet, origImg string, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) bool {
 	initImage := &ds.Spec.Template.Spec.InitContainers[0].Image
 	imgUpdate := *initImage != origImg
 	if imgUpdate {
@@ -538,7 +745,7 @@ func updateDaemonSetImages(ds *appsv1.DaemonSet, origImg string, nodesensor *fal
 }
 
 // handlePermissions creates and updates the service account, role and role binding
-func (r *FalconNodeSensorReconciler) handlePermissions(ctx context.Context, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) (bool, error) {
+func (r *FalconNodeSensorReconciler) handlePermissions(ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (bool, error) {
 	created, err := r.handleServiceAccount(ctx, nodesensor, logger)
 	if created || err != nil {
 		return created, err
@@ -548,10 +755,29 @@ func (r *FalconNodeSensorReconciler) handlePermissions(ctx context.Context, node
 }
 
 // handleRoleBinding creates and updates RoleBinding
-func (r *FalconNodeSensorReconciler) handleClusterRoleBinding(ctx context.Context, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) (bool, error) {
+func (r *FalconNodeSensorReconciler) handleClusterRoleBinding(ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (bool, error) {
+	subjects := []rbacv1.Subject{
+		{
+			Kind:      "ServiceAccount",
+			Name:      common.NodeServiceAccountName,
+			Namespace: nodesensor.TargetNs(),
+		},
+	}
+
 	binding := rbacv1.ClusterRoleBinding{}
 	err := r.Client.Get(ctx, types.NamespacedName{Name: common.NodeClusterRoleBindingName}, &binding)
-	if err == nil || (err != nil && !errors.IsNotFound(err)) {
+	if err == nil {
+		// The ServiceAccount moves along with the install namespace
+		if !reflect.DeepEqual(binding.Subjects, subjects) {
+			binding.Subjects = subjects
+			logger.Info("Updating FalconNodeSensor ClusterRoleBinding subjects", "Namespace", nodesensor.TargetNs())
+			err = r.Client.Update(ctx, &binding)
+			if err != nil {
+				logger.Error(err, "Failed to update ClusterRoleBinding", "ClusteRoleBinding.Name", common.NodeClusterRoleBindingName)
+			}
+		}
+		return false, err
+	} else if !errors.IsNotFound(err) {
 		return false, err
 	}
 	binding = rbacv1.ClusterRoleBinding{
@@ -567,13 +793,7 @@ func (r *FalconNodeSensorReconciler) handleClusterRoleBinding(ctx context.Contex
 			Kind:     "ClusterRole",
 			Name:     "falcon-operator-node-sensor-role",
 		},
-		Subjects: []rbacv1.Subject{
-			{
-				Kind:      "ServiceAccount",
-				Name:      common.NodeServiceAccountName,
-				Namespace: nodesensor.TargetNs(),
-			},
-		},
+		Subjects: subjects,
 	}
 	err = ctrl.SetControllerReference(nodesensor, &binding, r.Scheme)
 	if err != nil {
@@ -589,8 +809,64 @@ func (r *FalconNodeSensorReconciler) handleClusterRoleBinding(ctx context.Contex
 
 }
 
+// handlePreviousNamespaces removes the sensor resources left behind in namespaces the FalconNodeSensor was previously installed to.
+// The old DaemonSet is removed before the new one gets created, so that two sensors never run on the same node.
+func (r *FalconNodeSensorReconciler) handlePreviousNamespaces(ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	dsList := &appsv1.DaemonSetList{}
+	if err := r.List(ctx, dsList, &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{common.FalconComponentKey: common.FalconKernelSensor}),
+	}); err != nil {
+		return err
+	}
+
+	for i := range dsList.Items {
+		ds := &dsList.Items[i]
+		if ds.Namespace == nodesensor.TargetNs() || !metav1.IsControlledBy(ds, nodesensor) {
+			continue
+		}
+
+		logger.Info("FalconNodeSensor install namespace changed. Removing resources from the previous namespace", "Previous Namespace", ds.Namespace, "Namespace", nodesensor.TargetNs())
+		staleObjects := []client.Object{
+			&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: ds.Name, Namespace: ds.Namespace}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: nodesensor.Name + "-config", Namespace: ds.Namespace}},
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: common.FalconPullSecretName, Namespace: ds.Namespace}},
+			&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: common.NodeServiceAccountName, Namespace: ds.Namespace}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ds.Namespace}},
+		}
+		for _, obj := range staleObjects {
+			if err := r.deleteIfControlled(ctx, obj, nodesensor, logger); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// deleteIfControlled deletes the given object when it exists and is controlled by the FalconNodeSensor
+func (r *FalconNodeSensorReconciler) deleteIfControlled(ctx context.Context, obj client.Object, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	err := r.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(obj, nodesensor) {
+		return nil
+	}
+
+	err = r.Delete(ctx, obj)
+	if err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "Failed to delete object from previous namespace", "Namespace", obj.GetNamespace(), "Name", obj.GetName())
+		return err
+	}
+	logger.Info("Deleted object from previous namespace", "Namespace", obj.GetNamespace(), "Name", obj.GetName())
+	return nil
+}
+
 // handleServiceAccount creates and updates the service account and grants necessary permissions to it
-func (r *FalconNodeSensorReconciler) handleServiceAccount(ctx context.Context, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) (bool, error) {
+func (r *FalconNodeSensorReconciler) handleServiceAccount(ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (bool, error) {
 	sa := corev1.ServiceAccount{}
 	err := r.Client.Get(ctx, types.NamespacedName{Name: common.NodeServiceAccountName, Namespace: nodesensor.TargetNs()}, &sa)
 	if err == nil || (err != nil && !errors.IsNotFound(err)) {
@@ -620,7 +896,7 @@ func (r *FalconNodeSensorReconciler) handleServiceAccount(ctx context.Context, n
 }
 
 // handleServiceAccount creates and updates the service account and grants necessary permissions to it
-func (r *FalconNodeSensorReconciler) handleSAAnnotations(ctx context.Context, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) error {
+func (r *FalconNodeSensorReconciler) handleSAAnnotations(ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
 	sa := corev1.ServiceAccount{}
 	saAnnotations := nodesensor.Spec.Node.ServiceAccount.Annotations
 
@@ -650,8 +926,93 @@ func (r *FalconNodeSensorReconciler) handleSAAnnotations(ctx context.Context, no
 	return nil
 }
 
+// handleDaemonSetStatus mirrors the rollout health of the sensor DaemonSets into the FalconNodeSensor status
+func (r *FalconNodeSensorReconciler) handleDaemonSetStatus(ctx context.Context, images []node.SensorImage, daemonsets []*appsv1.DaemonSet, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	status := nodesensor.Status.DeepCopy()
+	if tag := images[0].Tag; tag != "" {
+		status.Sensor = &tag
+	}
+	status.ImageDigest = images[0].Digest
+	status.DesiredNumberScheduled = 0
+	status.NumberReady = 0
+	status.UpdatedNumberScheduled = 0
+	status.NumberUnavailable = 0
+	status.Architectures = make([]falconv1beta1.FalconNodeArchitectureStatus, 0, len(daemonsets))
+
+	observed := true
+	for i, daemonset := range daemonsets {
+		status.DesiredNumberScheduled += daemonset.Status.DesiredNumberScheduled
+		status.NumberReady += daemonset.Status.NumberReady
+		status.UpdatedNumberScheduled += daemonset.Status.UpdatedNumberScheduled
+		status.NumberUnavailable += daemonset.Status.NumberUnavailable
+		observed = observed && daemonset.Status.ObservedGeneration >= daemonset.Generation
+
+		status.Architectures = append(status.Architectures, falconv1beta1.FalconNodeArchitectureStatus{
+			Architecture:           falconv1beta1.NodeArchitecture(images[i].Architecture),
+			DaemonSet:              daemonset.Name,
+			Sensor:                 images[i].Tag,
+			ImageDigest:            images[i].Digest,
+			Image:                  images[i].URI,
+			DesiredNumberScheduled: daemonset.Status.DesiredNumberScheduled,
+			NumberReady:            daemonset.Status.NumberReady,
+		})
+	}
+
+	// Counts are only trusted once the DaemonSet controller has observed the latest spec of every DaemonSet
+	ready := metav1.Condition{
+		Type:               falconv1beta1.ConditionReady,
+		Status:             metav1.ConditionFalse,
+		Reason:             falconv1beta1.ReasonRolloutInProgress,
+		Message:            fmt.Sprintf("%d of %d nodes are running a ready Falcon Sensor", status.NumberReady, status.DesiredNumberScheduled),
+		ObservedGeneration: nodesensor.GetGeneration(),
+	}
+	if observed && status.NumberReady == status.DesiredNumberScheduled {
+		ready.Status = metav1.ConditionTrue
+		ready.Reason = falconv1beta1.ReasonRolloutComplete
+	}
+	meta.SetStatusCondition(&status.Conditions, ready)
+
+	if equality.Semantic.DeepEqual(status, &nodesensor.Status) {
+		return nil
+	}
+
+	nodesensor.Status = *status
+	if err := r.Status().Update(ctx, nodesensor); err != nil {
+		logger.Error(err, "Failed to update FalconNodeSensor status for DaemonSet rollout")
+		return err
+	}
+
+	return nil
+}
+
+// verifyImage blocks the rollout of a sensor image that fails signature verification and records the outcome in the ImageVerified condition
+func (r *FalconNodeSensorReconciler) verifyImage(ctx context.Context, config *node.ConfigCache, image string, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	if !verification.Enabled(nodesensor.Spec.ImageVerification) {
+		return nil
+	}
+
+	if err := config.VerifyImage(ctx, image); err != nil {
+		logger.Error(err, "Falcon Sensor image failed signature verification", "image", image)
+		updateErr := r.conditionsUpdate(falconv1beta1.ConditionImageVerified,
+			metav1.ConditionFalse,
+			falconv1beta1.ReasonVerificationFailed,
+			err.Error(),
+			ctx, nodesensor, logger)
+		if updateErr != nil {
+			return updateErr
+		}
+		return err
+	}
+
+	return r.conditionsUpdate(falconv1beta1.ConditionImageVerified,
+		metav1.ConditionTrue,
+		falconv1beta1.ReasonVerificationSucceeded,
+		fmt.Sprintf("Image %s passed signature verification", image),
+		ctx, nodesensor, logger)
+}
+
 // statusUpdate updates the FalconNodeSensor CR conditions
-func (r *FalconNodeSensorReconciler) conditionsUpdate(condType string, status metav1.ConditionStatus, reason string, message string, ctx context.Context, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) error {
+func (r *FalconNodeSensorReconciler) conditionsUpdate(condType string, status metav1.ConditionStatus, reason string, message string, ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
 	if !meta.IsStatusConditionPresentAndEqual(nodesensor.Status.Conditions, condType, status) {
 		meta.SetStatusCondition(&nodesensor.Status.Conditions, metav1.Condition{
 			Status:             status,
@@ -670,104 +1031,256 @@ func (r *FalconNodeSensorReconciler) conditionsUpdate(condType string, status me
 	return nil
 }
 
-// finalizeDaemonset deletes the Daemonset running the Falcon Sensor and then runs a Daemonset to cleanup the /opt/CrowdStrike directory
-func (r *FalconNodeSensorReconciler) finalizeDaemonset(ctx context.Context, image string, serviceAccount string, nodesensor *falconv1alpha1.FalconNodeSensor, logger logr.Logger) error {
-	dsCleanupName := nodesensor.Name + "-cleanup"
-	daemonset := &appsv1.DaemonSet{}
-	pods := corev1.PodList{}
-	dsList := &appsv1.DaemonSetList{}
-	var nodeCount int32 = 0
+// finalizeDaemonset deletes the Daemonsets running the Falcon Sensor and then runs a Daemonset for each node architecture to cleanup
+// the /opt/CrowdStrike directory. It never blocks waiting for the cleanup: each call advances the cleanup by one step and reports
+// whether it has finished, so the caller is expected to requeue until it returns true.
+func (r *FalconNodeSensorReconciler) finalizeDaemonset(ctx context.Context, serviceAccount string, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (bool, error) {
+	// A previous reconciliation already finished the cleanup
+	cond := meta.FindStatusCondition(nodesensor.Status.Conditions, falconv1beta1.ConditionFinalizing)
+	if cond != nil && (cond.Reason == falconv1beta1.ReasonCleanupSucceeded || cond.Reason == falconv1beta1.ReasonCleanupTimedOut) {
+		return true, nil
+	}
 
-	// Get a list of DS and return the DS within the correct NS
-	if err := r.List(ctx, dsList, &client.ListOptions{
-		LabelSelector: labels.SelectorFromSet(labels.Set{common.FalconComponentKey: common.FalconKernelSensor}),
-		Namespace:     nodesensor.TargetNs(),
-	}); err != nil {
-		return err
+	images, err := r.cleanupImages(ctx, nodesensor, logger)
+	if err != nil {
+		return false, err
 	}
 
-	// Delete the Daemonset containing the sensor
-	if err := r.Delete(ctx,
-		&appsv1.DaemonSet{
-			ObjectMeta: metav1.ObjectMeta{
-				Name: nodesensor.Name, Namespace: nodesensor.TargetNs(),
-			},
-		}); err != nil && !errors.IsNotFound(err) {
-		logger.Error(err, "Failed to cleanup Falcon sensor DaemonSet pods")
-		return err
+	// Delete the Daemonsets containing the sensor
+	deleted := false
+	for _, sensorImage := range images {
+		daemonset := &appsv1.DaemonSet{}
+		err := r.Get(ctx, types.NamespacedName{Name: node.DaemonSetName(nodesensor, sensorImage.Architecture), Namespace: nodesensor.TargetNs()}, daemonset)
+		if err == nil {
+			if err := r.Delete(ctx, daemonset); err != nil && !errors.IsNotFound(err) {
+				logger.Error(err, "Failed to cleanup Falcon sensor DaemonSet pods")
+				return false, err
+			}
+			deleted = true
+		} else if !errors.IsNotFound(err) {
+			logger.Error(err, "error getting the sensor DaemonSet")
+			return false, err
+		}
+	}
+	if deleted {
+		return false, r.finalizeConditionUpdate(metav1.ConditionTrue, falconv1beta1.ReasonSensorRemoved,
+			"FalconNodeSensor DaemonSet has been deleted", ctx, nodesensor, logger)
 	}
 
-	// Check if the cleanup DS is created. If not, create it.
-	err := r.Get(ctx, types.NamespacedName{Name: dsCleanupName, Namespace: nodesensor.TargetNs()}, daemonset)
-	if err != nil && errors.IsNotFound(err) {
-		// Define a new DS for cleanup
-		ds := assets.RemoveNodeDirDaemonset(dsCleanupName, image, serviceAccount, nodesensor)
+	// Check if the cleanup DS are created. If not, create them.
+	cleanupDSs := []*appsv1.DaemonSet{}
+	created := false
+	for _, sensorImage := range images {
+		dsCleanupName := node.DaemonSetName(nodesensor, sensorImage.Architecture) + "-cleanup"
+		cleanupDS := &appsv1.DaemonSet{}
+		err := r.Get(ctx, types.NamespacedName{Name: dsCleanupName, Namespace: nodesensor.TargetNs()}, cleanupDS)
+		if err != nil && errors.IsNotFound(err) {
+			ds := assets.RemoveNodeDirDaemonset(dsCleanupName, sensorImage.URI, sensorImage.Architecture, serviceAccount, nodesensor)
+
+			err = r.Create(ctx, ds)
+			if err != nil {
+				logger.Error(err, "Failed to delete node directory with cleanup DaemonSet", "Path", common.FalconHostInstallDir)
+				return false, err
+			}
+			created = true
+			continue
+		} else if err != nil {
+			logger.Error(err, "error getting the cleanup DaemonSet")
+			return false, err
+		}
+		cleanupDSs = append(cleanupDSs, cleanupDS)
+	}
+	if created {
+		return false, r.finalizeConditionUpdate(metav1.ConditionTrue, falconv1beta1.ReasonCleanupRunning,
+			"Waiting for cleanup DaemonSet to run on all nodes", ctx, nodesensor, logger)
+	}
 
-		// Create the cleanup DS
-		err = r.Create(ctx, ds)
+	completed, failed, nodeCount := 0, 0, 0
+	finished := true
+	startedAt := time.Now()
+	for _, cleanupDS := range cleanupDSs {
+		dsCompleted, dsFailed, observed, err := r.cleanupProgress(ctx, cleanupDS, nodesensor, logger)
 		if err != nil {
-			logger.Error(err, "Failed to delete node directory with cleanup DaemonSet", "Path", common.FalconHostInstallDir)
-			return err
+			return false, err
+		}
+		dsNodeCount := int(cleanupDS.Status.DesiredNumberScheduled)
+		completed += dsCompleted
+		failed += dsFailed
+		nodeCount += dsNodeCount
+		finished = finished && observed && dsCompleted >= dsNodeCount
+		if cleanupDS.CreationTimestamp.Time.Before(startedAt) {
+			startedAt = cleanupDS.CreationTimestamp.Time
 		}
+	}
 
-		// Start inifite loop to check that all pods have either completed or are running in the DS
-		for {
-			// List all pods with the "cleanup" label in the appropriate NS
-			if err := r.List(ctx, &pods, &client.ListOptions{
-				LabelSelector: labels.SelectorFromSet(labels.Set{common.FalconInstanceNameKey: "cleanup"}),
-				Namespace:     nodesensor.TargetNs(),
-			}); err != nil {
-				return err
+	if finished {
+		// The cleanup DS have finished on every node so delete them
+		if err := r.deleteCleanupDaemonsets(ctx, cleanupDSs, logger); err != nil {
+			return false, err
+		}
+
+		// If we have gotten here, the cleanup should be successful
+		logger.Info("Successfully deleted node directory", "Path", common.FalconDataDir)
+		return true, r.finalizeConditionUpdate(metav1.ConditionTrue, falconv1beta1.ReasonCleanupSucceeded,
+			fmt.Sprintf("Node cleanup completed on %d node(s)", completed), ctx, nodesensor, logger)
+	}
+
+	timeout := time.Duration(nodesensor.Spec.Node.CleanupTimeout) * time.Second
+	if timeout <= 0 {
+		timeout = defaultCleanupTimeout
+	}
+	if time.Since(startedAt) > timeout {
+		// Give up rather than blocking the deletion of the FalconNodeSensor forever
+		if err := r.deleteCleanupDaemonsets(ctx, cleanupDSs, logger); err != nil {
+			return false, err
+		}
+
+		logger.Info("Timed out waiting for node cleanup", "Timeout", timeout, "Completed", completed, "Failed", failed, "Nodes", nodeCount)
+		return true, r.finalizeConditionUpdate(metav1.ConditionFalse, falconv1beta1.ReasonCleanupTimedOut,
+			fmt.Sprintf("Node cleanup timed out after %s: completed on %d of %d node(s), %d failed", timeout, completed, nodeCount, failed), ctx, nodesensor, logger)
+	}
+
+	logger.Info("Waiting for cleanup pods to complete. Retrying....", "Completed", completed, "Failed", failed, "Nodes", nodeCount)
+	return false, r.finalizeConditionUpdate(metav1.ConditionTrue, falconv1beta1.ReasonCleanupRunning,
+		fmt.Sprintf("Node cleanup completed on %d of %d node(s), %d failed", completed, nodeCount, failed), ctx, nodesensor, logger)
+}
+
+// cleanupImages returns the sensor image of each architecture the FalconNodeSensor deployed to. The images are taken from the
+// status, or from the sensor DaemonSets for sensors deployed before the status recorded them, and are then recorded in the
+// status so that they are still known once the sensor DaemonSets are deleted. Architectures without a deployed sensor are skipped.
+func (r *FalconNodeSensorReconciler) cleanupImages(ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) ([]node.SensorImage, error) {
+	status := nodesensor.Status.DeepCopy()
+	recorded := map[string]bool{}
+	for _, arch := range status.Architectures {
+		recorded[string(arch.Architecture)] = true
+	}
+	for _, arch := range node.Architectures(nodesensor) {
+		if !recorded[arch] {
+			status.Architectures = append(status.Architectures, falconv1beta1.FalconNodeArchitectureStatus{
+				Architecture: falconv1beta1.NodeArchitecture(arch),
+				DaemonSet:    node.DaemonSetName(nodesensor, arch),
+			})
+		}
+	}
+
+	images := []node.SensorImage{}
+	deployed := []falconv1beta1.FalconNodeArchitectureStatus{}
+	for _, arch := range status.Architectures {
+		if arch.Image == "" {
+			daemonset := &appsv1.DaemonSet{}
+			err := r.Get(ctx, types.NamespacedName{Name: node.DaemonSetName(nodesensor, string(arch.Architecture)), Namespace: nodesensor.TargetNs()}, daemonset)
+			if err != nil && !errors.IsNotFound(err) {
+				logger.Error(err, "error getting the sensor DaemonSet")
+				return nil, err
 			}
-			// Reset completedCount each loop, to ensure we don't count the same node(s) multiple times
-			var completedCount int32 = 0
-			// Reset the nodeCount to the desired number of pods to be scheduled for cleanup each loop, in case the cluster has scaled down
-			for _, dSet := range dsList.Items {
-				nodeCount = dSet.Status.DesiredNumberScheduled
-				logger.Info("Setting DaemonSet node count", "Number of nodes", nodeCount)
+			if err == nil && len(daemonset.Spec.Template.Spec.Containers) > 0 {
+				arch.Image = daemonset.Spec.Template.Spec.Containers[0].Image
 			}
+		}
+		if arch.Image != "" {
+			images = append(images, node.SensorImage{Architecture: string(arch.Architecture), URI: arch.Image})
+			deployed = append(deployed, arch)
+		}
+	}
 
-			// When the pods have a status of completed or running, increment the count.
-			// The reason running is an acceptable value is because the pods should be running the sleep command and have already cleaned up /opt/CrowdStrike
-			for _, pod := range pods.Items {
-				if pod.Status.Phase == "Completed" || pod.Status.Phase == "Running" || pod.Status.Phase == "CrashLoopBackOff" {
-					completedCount++
-				}
-			}
+	status.Architectures = deployed
+	if !equality.Semantic.DeepEqual(status.Architectures, nodesensor.Status.Architectures) {
+		nodesensor.Status = *status
+		if err := r.Status().Update(ctx, nodesensor); err != nil {
+			logger.Error(err, "Failed to update FalconNodeSensor status for the node cleanup images")
+			return nil, err
+		}
+	}
 
-			// Break out of the infinite loop for cleanup when the completed or running DS count reaches the desired node count
-			if completedCount == nodeCount {
-				logger.Info("Clean up pods should be done. Continuing deleting.")
-				break
-			} else if completedCount < nodeCount && completedCount > 0 {
-				logger.Info("Waiting for cleanup pods to complete. Retrying....", "Number of pods still processing task", completedCount)
-			}
+	return images, nil
+}
+
+// cleanupProgress counts the nodes on which the cleanup DaemonSet completed or failed. Nodes are only counted once the
+// DaemonSet controller has observed the cleanup DS.
+func (r *FalconNodeSensorReconciler) cleanupProgress(ctx context.Context, cleanupDS *appsv1.DaemonSet, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) (int, int, bool, error) {
+	if cleanupDS.Status.ObservedGeneration < cleanupDS.Generation {
+		return 0, 0, false, nil
+	}
+
+	pods := corev1.PodList{}
+	if err := r.List(ctx, &pods, &client.ListOptions{
+		LabelSelector: labels.SelectorFromSet(cleanupDS.Spec.Selector.MatchLabels),
+		Namespace:     nodesensor.TargetNs(),
+	}); err != nil {
+		return 0, 0, false, err
+	}
 
-			err = r.Get(ctx, types.NamespacedName{Name: dsCleanupName, Namespace: nodesensor.TargetNs()}, daemonset)
-			if err != nil && errors.IsNotFound(err) {
-				logger.Info("Clean-up daemonset has been removed")
-				break
+	completed, failed := 0, 0
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if exitCode := cleanupPodExitCode(pod); exitCode != nil {
+			if *exitCode == 0 {
+				completed++
+			} else {
+				failed++
+				logger.Info("Cleanup failed on node, waiting for retry", "Node", pod.Spec.NodeName, "ExitCode", *exitCode)
 			}
 		}
+	}
+	return completed, failed, true, nil
+}
 
-		// The cleanup DS should be completed so delete the cleanup DS
-		if err := r.Delete(ctx,
-			&appsv1.DaemonSet{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: dsCleanupName, Namespace: nodesensor.TargetNs(),
-				},
-			}); err != nil && !errors.IsNotFound(err) {
-			logger.Error(err, "Failed to cleanup Falcon sensor DaemonSet pods")
+// deleteCleanupDaemonsets removes the cleanup DaemonSets once they are no longer needed
+func (r *FalconNodeSensorReconciler) deleteCleanupDaemonsets(ctx context.Context, cleanupDSs []*appsv1.DaemonSet, logger logr.Logger) error {
+	for _, cleanupDS := range cleanupDSs {
+		if err := r.deleteCleanupDaemonset(ctx, cleanupDS, logger); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		// If we have gotten here, the cleanup should be successful
-		logger.Info("Successfully deleted node directory", "Path", common.FalconDataDir)
-	} else if err != nil {
-		logger.Error(err, "error getting the cleanup DaemonSet")
+// deleteCleanupDaemonset removes the cleanup DaemonSet once it is no longer needed
+func (r *FalconNodeSensorReconciler) deleteCleanupDaemonset(ctx context.Context, cleanupDS *appsv1.DaemonSet, logger logr.Logger) error {
+	if err := r.Delete(ctx, cleanupDS); err != nil && !errors.IsNotFound(err) {
+		logger.Error(err, "Failed to cleanup Falcon sensor DaemonSet pods")
+		return err
+	}
+	return nil
+}
+
+// cleanupPodExitCode returns the exit code of the cleanup init container of the pod, or nil when it has not finished yet
+func cleanupPodExitCode(pod *corev1.Pod) *int32 {
+	for _, status := range pod.Status.InitContainerStatuses {
+		if status.Name != common.FalconCleanupInitContainerName {
+			continue
+		}
+		if status.State.Terminated != nil {
+			return &status.State.Terminated.ExitCode
+		}
+		// A failed cleanup is restarted by the kubelet, so report the previous failure while it waits
+		if status.LastTerminationState.Terminated != nil {
+			return &status.LastTerminationState.Terminated.ExitCode
+		}
+	}
+	return nil
+}
+
+// finalizeConditionUpdate records the progress of the FalconNodeSensor finalization. Unlike conditionsUpdate,
+// it also updates the condition when only the reason or message changed.
+func (r *FalconNodeSensorReconciler) finalizeConditionUpdate(status metav1.ConditionStatus, reason string, message string, ctx context.Context, nodesensor *falconv1beta1.FalconNodeSensor, logger logr.Logger) error {
+	cond := meta.FindStatusCondition(nodesensor.Status.Conditions, falconv1beta1.ConditionFinalizing)
+	if cond != nil && cond.Status == status && cond.Reason == reason && cond.Message == message {
+		return nil
+	}
+
+	meta.SetStatusCondition(&nodesensor.Status.Conditions, metav1.Condition{
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		Type:               falconv1beta1.ConditionFinalizing,
+		ObservedGeneration: nodesensor.GetGeneration(),
+	})
+
+	if err := r.Status().Update(ctx, nodesensor); err != nil {
+		logger.Error(err, "Failed to update FalconNodeSensor status", "Failed to update the Condition at Reasoning", reason)
 		return err
 	}
 
-	logger.Info("Successfully finalized daemonset")
 	return nil
 }
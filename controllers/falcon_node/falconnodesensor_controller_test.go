@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"time"
 
-	falconv1alpha1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	appsv1 "k8s.io/api/apps/v1"
@@ -49,21 +49,21 @@ var _ = Describe("FalconNodeSensor controller", func() {
 
 		It("should successfully reconcile a custom resource for FalconNodeSensor", func() {
 			By("Creating the custom resource for the Kind FalconNodeSensor")
-			falconNode := &falconv1alpha1.FalconNodeSensor{}
+			falconNode := &falconv1beta1.FalconNodeSensor{}
 			err := k8sClient.Get(ctx, typeNamespaceName, falconNode)
 			if err != nil && errors.IsNotFound(err) {
 				// Let's mock our custom resource at the same way that we would
 				// apply on the cluster the manifest under config/samples
-				falconNode := &falconv1alpha1.FalconNodeSensor{
+				falconNode := &falconv1beta1.FalconNodeSensor{
 					ObjectMeta: metav1.ObjectMeta{
 						Name:      NodeSensorName,
 						Namespace: namespace.Name,
 					},
-					Spec: falconv1alpha1.FalconNodeSensorSpec{
-						Falcon: falconv1alpha1.FalconSensor{
+					Spec: falconv1beta1.FalconNodeSensorSpec{
+						Falcon: falconv1beta1.FalconSensor{
 							CID: &falconCID,
 						},
-						Node: falconv1alpha1.FalconNodeSensorConfig{
+						Node: falconv1beta1.FalconNodeSensorConfig{
 							Image: "example.com/image:test",
 						},
 					},
@@ -75,7 +75,7 @@ var _ = Describe("FalconNodeSensor controller", func() {
 
 			By("Checking if the custom resource was successfully created")
 			Eventually(func() error {
-				found := &falconv1alpha1.FalconNodeSensor{}
+				found := &falconv1beta1.FalconNodeSensor{}
 				return k8sClient.Get(ctx, typeNamespaceName, found)
 			}, time.Minute, time.Second).Should(Succeed())
 
@@ -129,8 +129,8 @@ var _ = Describe("FalconNodeSensor controller", func() {
 			Eventually(func() error {
 				if falconNode.Status.Conditions != nil && len(falconNode.Status.Conditions) != 0 {
 					latestStatusCondition := falconNode.Status.Conditions[len(falconNode.Status.Conditions)-1]
-					expectedLatestStatusCondition := metav1.Condition{Type: falconv1alpha1.ConditionDaemonSetReady,
-						Status: metav1.ConditionTrue, Reason: falconv1alpha1.ReasonInstallSucceeded,
+					expectedLatestStatusCondition := metav1.Condition{Type: falconv1beta1.ConditionDaemonSetReady,
+						Status: metav1.ConditionTrue, Reason: falconv1beta1.ReasonInstallSucceeded,
 						Message: "FalconNodeSensor DaemonSet has been successfully installed"}
 					if latestStatusCondition != expectedLatestStatusCondition {
 						return fmt.Errorf("The latest status condition added to the FalconNodeSensor instance is not as expected")
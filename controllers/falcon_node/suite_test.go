@@ -18,7 +18,7 @@ import (
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
-	falconv1alpha1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	//+kubebuilder:scaffold:imports
 )
 
@@ -53,7 +53,7 @@ var _ = BeforeSuite(func() {
 	err = securityv1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
-	err = falconv1alpha1.AddToScheme(scheme.Scheme)
+	err = falconv1beta1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
 
 	//+kubebuilder:scaffold:scheme
@@ -5,11 +5,13 @@ Copyright 2021 CrowdStrike
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net/http"
 	"net/http/pprof"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -23,6 +25,7 @@ import (
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
@@ -32,11 +35,15 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	v1alpha1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	v1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	containercontroller "github.com/crowdstrike/falcon-operator/controllers/falcon_container"
+	deploymentcontroller "github.com/crowdstrike/falcon-operator/controllers/falcon_deployment"
 	nodecontroller "github.com/crowdstrike/falcon-operator/controllers/falcon_node"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
+	"github.com/crowdstrike/falcon-operator/pkg/webhook_cert"
 	"github.com/crowdstrike/falcon-operator/version"
 	// +kubebuilder:scaffold:imports
 )
@@ -50,8 +57,10 @@ func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(imagev1.AddToScheme(scheme))
 	utilruntime.Must(securityv1.AddToScheme(scheme))
+	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 
 	utilruntime.Must(v1alpha1.AddToScheme(scheme))
+	utilruntime.Must(v1beta1.AddToScheme(scheme))
 	// +kubebuilder:scaffold:scheme
 }
 
@@ -101,17 +110,19 @@ func main() {
 	}
 
 	setupLog.Info("setting up manager to watch resources", "watchNamespace", watchNamespace)
+	certDir := filepath.Join(os.TempDir(), "k8s-webhook-server", "serving-certs")
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
 		Port:                   9443,
+		CertDir:                certDir,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "70435a7a.crowdstrike.com",
 		Namespace:              watchNamespace, // namespaced-scope when the value is not an empty string
 		NewCache: cache.BuilderWithOptions(cache.Options{
 			SelectorsByObject: cache.SelectorsByObject{
-				&v1alpha1.FalconContainer{}:  {},
+				&v1beta1.FalconContainer{}:   {},
 				&corev1.Namespace{}:          {},
 				&rbacv1.ClusterRoleBinding{}: {},
 				&corev1.ServiceAccount{}:     {},
@@ -132,6 +143,12 @@ func main() {
 				&arv1.MutatingWebhookConfiguration{}: {
 					Label: labels.SelectorFromSet(containercontroller.FcLabels),
 				},
+				&corev1.Pod{}: {
+					Label: labels.SelectorFromSet(labels.Set{common.FalconProviderKey: common.FalconProviderValue}),
+				},
+				&corev1.Node{}: {
+					Label: labels.SelectorFromSet(labels.Set{common.FalconNodeSensorLabel: common.FalconNodeSensorLabelValue}),
+				},
 			},
 		},
 		),
@@ -145,6 +162,7 @@ func main() {
 		Client:     mgr.GetClient(),
 		Scheme:     mgr.GetScheme(),
 		RestConfig: mgr.GetConfig(),
+		Recorder:   mgr.GetEventRecorderFor("falcon-container-controller"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "FalconContainer")
 		os.Exit(1)
@@ -156,6 +174,35 @@ func main() {
 		setupLog.Error(err, "unable to create controller", "controller", "FalconNodeSensor")
 		os.Exit(1)
 	}
+	if err = (&deploymentcontroller.FalconDeploymentReconciler{
+		Client: mgr.GetClient(),
+		Scheme: mgr.GetScheme(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "FalconDeployment")
+		os.Exit(1)
+	}
+	// The webhooks need a serving certificate, set ENABLE_WEBHOOKS=false where none is provisioned
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		// Without OLM or cert-manager the operator provisions the serving certificate itself
+		if secretName := os.Getenv("WEBHOOK_CERT_SECRET"); secretName != "" {
+			if err = provisionWebhookCert(mgr, secretName, certDir); err != nil {
+				setupLog.Error(err, "unable to provision webhook serving certificate")
+				os.Exit(1)
+			}
+		}
+		if err = (&v1beta1.FalconContainer{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "FalconContainer")
+			os.Exit(1)
+		}
+		if err = (&v1beta1.FalconNodeSensor{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "FalconNodeSensor")
+			os.Exit(1)
+		}
+		if err = (&v1beta1.FalconDeployment{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "FalconDeployment")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("health", healthz.Ping); err != nil {
@@ -202,3 +249,25 @@ func getWatchNamespace() (string, error) {
 	}
 	return ns, nil
 }
+
+// provisionWebhookCert sets up the webhook serving certificate before the manager starts, its cached client is not running yet
+func provisionWebhookCert(mgr ctrl.Manager, secretName string, certDir string) error {
+	cli, err := client.New(mgr.GetConfig(), client.Options{Scheme: mgr.GetScheme()})
+	if err != nil {
+		return err
+	}
+
+	serviceName := os.Getenv("WEBHOOK_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = "falcon-operator-webhook-service"
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	return webhook_cert.Provision(ctx, cli, setupLog, webhook_cert.Options{
+		Namespace:   common.FalconOperatorNamespace,
+		SecretName:  secretName,
+		ServiceName: serviceName,
+		CertDir:     certDir,
+	})
+}
@@ -3,20 +3,24 @@ package aws
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	ecr_types "github.com/aws/aws-sdk-go-v2/service/ecr/types"
+	"github.com/aws/smithy-go"
 )
 
-func (c *Config) UpsertRepository(ctx context.Context, name string) (*ecr_types.Repository, error) {
+// UpsertRepository returns the named ECR repository, creating it when it does not exist yet. created reports whether the
+// repository was created by this call.
+func (c *Config) UpsertRepository(ctx context.Context, name string) (repository *ecr_types.Repository, created bool, err error) {
 	client := ecr.NewFromConfig(c.Config)
 
 	describeOutput, err := client.DescribeRepositories(ctx, &ecr.DescribeRepositoriesInput{
 		RepositoryNames: []string{name},
 	})
 	if err == nil && describeOutput != nil && len(describeOutput.Repositories) == 1 {
-		return &describeOutput.Repositories[0], nil
+		return &describeOutput.Repositories[0], false, nil
 	}
 
 	createOutput, err := client.CreateRepository(ctx, &ecr.CreateRepositoryInput{
@@ -24,10 +28,42 @@ func (c *Config) UpsertRepository(ctx context.Context, name string) (*ecr_types.
 	})
 
 	if err != nil {
-		return nil, fmt.Errorf("Could not create ECR repository %s: %v", name, err)
+		return nil, false, fmt.Errorf("Could not create ECR repository %s: %v", name, err)
 	}
 
-	return createOutput.Repository, nil
+	return createOutput.Repository, true, nil
+}
+
+// DeleteRepository deletes the named ECR repository. Unless force is set, a repository that still holds images is not deleted.
+// IsRepositoryRetained reports whether the returned error means the repository was left in place on purpose or for lack of
+// permissions.
+func (c *Config) DeleteRepository(ctx context.Context, name string, force bool) error {
+	client := ecr.NewFromConfig(c.Config)
+
+	_, err := client.DeleteRepository(ctx, &ecr.DeleteRepositoryInput{
+		RepositoryName: &name,
+		Force:          force,
+	})
+	if err != nil {
+		var notFound *ecr_types.RepositoryNotFoundException
+		if errors.As(err, &notFound) {
+			return nil
+		}
+		return fmt.Errorf("Could not delete ECR repository %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// IsRepositoryRetained reports whether the error returned by DeleteRepository means the repository still holds images or the
+// operator is not allowed to delete it. Retrying will not help in either case.
+func IsRepositoryRetained(err error) bool {
+	var notEmpty *ecr_types.RepositoryNotEmptyException
+	if errors.As(err, &notEmpty) {
+		return true
+	}
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "AccessDeniedException"
 }
 
 func (c *Config) ECRLogin(ctx context.Context) ([]byte, error) {
@@ -2,6 +2,8 @@ package common
 
 const (
 	FalconContainerInjection               = "sensor.falcon-system.crowdstrike.com/injection"
+	FalconNodeSensorLabel                  = "sensor.falcon-system.crowdstrike.com/node-sensor"
+	FalconNodeSensorLabelValue             = "enabled"
 	FalconContainerInjectorTLSName         = "injector-tls"
 	FalconHostInstallDir                   = "/opt"
 	FalconInitHostInstallDir               = "/host_opt"
@@ -15,6 +17,8 @@ const (
 	FalconContainerProbePath               = "/live"
 	FalconServiceHTTPSName                 = "https"
 	FalconServiceHTTPSPort                 = 443
+	FalconCleanupInitContainerName         = "cleanup-opt-crowdstrike"
+	NodeArchitectureLabel                  = "kubernetes.io/arch"
 
 	FalconInstanceNameKey = "crowdstrike.com/name"
 	FalconInstanceKey     = "crowdstrike.com/instance"
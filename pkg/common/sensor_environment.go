@@ -4,10 +4,11 @@ import (
 	"strconv"
 	"strings"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 )
 
-func MakeSensorEnvMap(falconSensor v1alpha1.FalconSensor) map[string]string {
+// MakeSensorEnvMap renders the sensor settings as the FALCONCTL_OPT_* environment variables read by the sensor
+func MakeSensorEnvMap(falconSensor v1beta1.FalconSensor) map[string]string {
 	sensorConfig := make(map[string]string)
 
 	if falconSensor.APD != nil {
@@ -22,8 +23,8 @@ func MakeSensorEnvMap(falconSensor v1alpha1.FalconSensor) map[string]string {
 	if falconSensor.Billing != "" {
 		sensorConfig["FALCONCTL_OPT_BILLING"] = falconSensor.Billing
 	}
-	if falconSensor.PToken != "" {
-		sensorConfig["FALCONCTL_OPT_PROVISIONING_TOKEN"] = falconSensor.PToken
+	if falconSensor.ProvisioningToken != "" {
+		sensorConfig["FALCONCTL_OPT_PROVISIONING_TOKEN"] = falconSensor.ProvisioningToken
 	}
 	if len(falconSensor.Tags) > 0 {
 		sensorConfig["FALCONCTL_OPT_TAGS"] = strings.Join(falconSensor.Tags, ",")
@@ -31,5 +32,18 @@ func MakeSensorEnvMap(falconSensor v1alpha1.FalconSensor) map[string]string {
 	if falconSensor.Trace != "" {
 		sensorConfig["FALCONCTL_OPT_TRACE"] = falconSensor.Trace
 	}
+	if len(falconSensor.Features) > 0 {
+		features := make([]string, 0, len(falconSensor.Features))
+		for _, feature := range falconSensor.Features {
+			features = append(features, string(feature))
+		}
+		sensorConfig["FALCONCTL_OPT_FEATURE"] = strings.Join(features, ",")
+	}
+	if falconSensor.MessageLog != nil {
+		sensorConfig["FALCONCTL_OPT_MESSAGE_LOG"] = strconv.FormatBool(*falconSensor.MessageLog)
+	}
+	if falconSensor.Update != nil {
+		sensorConfig["FALCONCTL_OPT_UPDATE"] = strconv.FormatBool(*falconSensor.Update)
+	}
 	return sensorConfig
 }
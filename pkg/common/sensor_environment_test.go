@@ -0,0 +1,54 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMakeSensorEnvMap(t *testing.T) {
+	enabled := true
+	disabled := false
+	port := 8080
+
+	tests := []struct {
+		name   string
+		sensor v1beta1.FalconSensor
+		want   map[string]string
+	}{
+		{"empty", v1beta1.FalconSensor{}, map[string]string{}},
+		{"proxy", v1beta1.FalconSensor{APD: &disabled, APH: "proxy.example.com", APP: &port}, map[string]string{
+			"FALCONCTL_OPT_APD": "false",
+			"FALCONCTL_OPT_APH": "proxy.example.com",
+			"FALCONCTL_OPT_APP": "8080",
+		}},
+		{"billing and provisioning", v1beta1.FalconSensor{Billing: "metered", ProvisioningToken: "abcdef01"}, map[string]string{
+			"FALCONCTL_OPT_BILLING":            "metered",
+			"FALCONCTL_OPT_PROVISIONING_TOKEN": "abcdef01",
+		}},
+		{"tags and trace", v1beta1.FalconSensor{Tags: []string{"k8s", "prod/eu"}, Trace: "debug"}, map[string]string{
+			"FALCONCTL_OPT_TAGS":  "k8s,prod/eu",
+			"FALCONCTL_OPT_TRACE": "debug",
+		}},
+		{"features", v1beta1.FalconSensor{Features: []v1beta1.FalconSensorFeature{v1beta1.FalconSensorFeatureEnableLog, v1beta1.FalconSensorFeatureDisableOsfm}}, map[string]string{
+			"FALCONCTL_OPT_FEATURE": "enableLog,disableOsfm",
+		}},
+		{"clear features", v1beta1.FalconSensor{Features: []v1beta1.FalconSensorFeature{v1beta1.FalconSensorFeatureNone}}, map[string]string{
+			"FALCONCTL_OPT_FEATURE": "none",
+		}},
+		{"message log and update", v1beta1.FalconSensor{MessageLog: &enabled, Update: &disabled}, map[string]string{
+			"FALCONCTL_OPT_MESSAGE_LOG": "true",
+			"FALCONCTL_OPT_UPDATE":      "false",
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MakeSensorEnvMap(tt.sensor)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("MakeSensorEnvMap() mismatch (-want +got): %s", diff)
+			}
+		})
+	}
+}
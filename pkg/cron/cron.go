@@ -0,0 +1,195 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Schedule is a parsed standard cron expression with the fields minute, hour, day of month, month and day of week
+type Schedule struct {
+	minute     uint64
+	hour       uint64
+	dayOfMonth uint64
+	month      uint64
+	dayOfWeek  uint64
+	// Standard cron matches a day when either the day of month or the day of week matches, unless one is a wildcard
+	dayOfMonthAny bool
+	dayOfWeekAny  bool
+}
+
+type field struct {
+	name     string
+	min, max int
+}
+
+var fields = []field{
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// maxSearch bounds how far Next looks ahead; it covers the leap day schedules
+const maxSearch = 5 * 366 * 24 * time.Hour
+
+// Parse parses a cron expression such as "30 2 * * 1-5", or one of the macros @hourly, @daily, @weekly, @monthly and @yearly
+func Parse(expression string) (*Schedule, error) {
+	spec := strings.TrimSpace(expression)
+	if macro, ok := macros[spec]; ok {
+		spec = macro
+	}
+
+	parts := strings.Fields(spec)
+	if len(parts) != len(fields) {
+		return nil, fmt.Errorf("invalid cron expression %q: expected %d fields, found %d", expression, len(fields), len(parts))
+	}
+
+	bits := make([]uint64, len(fields))
+	for i, part := range parts {
+		b, err := parseField(part, fields[i])
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression %q: %v", expression, err)
+		}
+		bits[i] = b
+	}
+
+	// Sunday is both 0 and 7
+	if bits[4]&(1<<7) != 0 {
+		bits[4] |= 1
+	}
+
+	return &Schedule{
+		minute:        bits[0],
+		hour:          bits[1],
+		dayOfMonth:    bits[2],
+		month:         bits[3],
+		dayOfWeek:     bits[4],
+		dayOfMonthAny: strings.HasPrefix(parts[2], "*"),
+		dayOfWeekAny:  strings.HasPrefix(parts[4], "*"),
+	}, nil
+}
+
+func parseField(spec string, f field) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(spec, ",") {
+		rangeSpec, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid step in %s field: %s", f.name, item)
+			}
+			rangeSpec, step = item[:i], n
+		}
+
+		low, high := f.min, f.max
+		switch {
+		case rangeSpec == "*":
+		case strings.Contains(rangeSpec, "-"):
+			bounds := strings.SplitN(rangeSpec, "-", 2)
+			var err error
+			if low, err = strconv.Atoi(bounds[0]); err != nil {
+				return 0, fmt.Errorf("invalid range in %s field: %s", f.name, item)
+			}
+			if high, err = strconv.Atoi(bounds[1]); err != nil {
+				return 0, fmt.Errorf("invalid range in %s field: %s", f.name, item)
+			}
+		default:
+			n, err := strconv.Atoi(rangeSpec)
+			if err != nil {
+				return 0, fmt.Errorf("invalid value in %s field: %s", f.name, item)
+			}
+			low, high = n, n
+			// "5/15" means every 15 starting at 5
+			if step > 1 {
+				high = f.max
+			}
+		}
+
+		if low < f.min || high > f.max || low > high {
+			return 0, fmt.Errorf("%s field out of range %d-%d: %s", f.name, f.min, f.max, item)
+		}
+		for v := low; v <= high; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+// Matches reports whether the schedule fires at the minute of the given time
+func (s *Schedule) Matches(t time.Time) bool {
+	return s.minute&(1<<uint(t.Minute())) != 0 && s.hour&(1<<uint(t.Hour())) != 0 && s.matchesDay(t)
+}
+
+func (s *Schedule) matchesDay(t time.Time) bool {
+	if s.month&(1<<uint(t.Month())) == 0 {
+		return false
+	}
+
+	dom := s.dayOfMonth&(1<<uint(t.Day())) != 0
+	dow := s.dayOfWeek&(1<<uint(t.Weekday())) != 0
+	if s.dayOfMonthAny || s.dayOfWeekAny {
+		return dom && dow
+	}
+	return dom || dow
+}
+
+// Next returns the first time at or after t, truncated to the minute, at which the schedule fires.
+// It returns the zero time when the schedule never fires, as with "0 0 30 2 *".
+func (s *Schedule) Next(t time.Time) time.Time {
+	next := t.Truncate(time.Minute)
+	if next.Before(t) {
+		next = next.Add(time.Minute)
+	}
+
+	end := t.Add(maxSearch)
+	for next.Before(end) {
+		if !s.matchesDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, next.Location())
+			continue
+		}
+		if s.hour&(1<<uint(next.Hour())) == 0 {
+			next = next.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if s.minute&(1<<uint(next.Minute())) == 0 {
+			next = next.Add(time.Minute)
+			continue
+		}
+		return next
+	}
+	return time.Time{}
+}
+
+// Window is a recurring period of time that opens whenever the schedule fires and stays open for the duration
+type Window struct {
+	Schedule *Schedule
+	Duration time.Duration
+}
+
+// Open reports whether the window is open at the given time
+func (w Window) Open(t time.Time) bool {
+	// The window that opened last before t is open when it opened less than the duration ago
+	start := w.Schedule.Next(t.Add(-w.Duration).Add(time.Nanosecond))
+	return !start.IsZero() && !start.After(t)
+}
+
+// NextOpen returns the time at which the window opens next, or t when it is already open
+func (w Window) NextOpen(t time.Time) time.Time {
+	if w.Open(t) {
+		return t
+	}
+	return w.Schedule.Next(t)
+}
@@ -0,0 +1,103 @@
+package cron
+
+import (
+	"testing"
+	"time"
+)
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("time.Parse() error = %v", err)
+	}
+	return parsed
+}
+
+func TestParseInvalid(t *testing.T) {
+	for _, expression := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "*/0 * * * *", "5-1 * * * *", "a * * * *", "@reboot"} {
+		if _, err := Parse(expression); err == nil {
+			t.Errorf("Parse(%q) expected an error", expression)
+		}
+	}
+}
+
+func TestNext(t *testing.T) {
+	tests := []struct {
+		expression string
+		from       string
+		want       string
+	}{
+		{"0 2 * * *", "2022-11-07T01:30:00Z", "2022-11-07T02:00:00Z"},
+		{"0 2 * * *", "2022-11-07T02:00:00Z", "2022-11-07T02:00:00Z"},
+		{"0 2 * * *", "2022-11-07T02:00:30Z", "2022-11-08T02:00:00Z"},
+		{"*/15 * * * *", "2022-11-07T10:16:00Z", "2022-11-07T10:30:00Z"},
+		{"5/20 * * * *", "2022-11-07T10:26:00Z", "2022-11-07T10:45:00Z"},
+		{"30 22 * * 1-5", "2022-11-11T23:00:00Z", "2022-11-14T22:30:00Z"},
+		{"0 3 * * 7", "2022-11-07T00:00:00Z", "2022-11-13T03:00:00Z"},
+		{"0 0 1,15 * *", "2022-11-02T00:00:00Z", "2022-11-15T00:00:00Z"},
+		{"0 0 13 * 5", "2022-11-02T00:00:00Z", "2022-11-04T00:00:00Z"},
+		{"0 0 29 2 *", "2022-03-01T00:00:00Z", "2024-02-29T00:00:00Z"},
+		{"@weekly", "2022-11-07T00:00:00Z", "2022-11-13T00:00:00Z"},
+		{"0 12 31 12 *", "2022-12-31T12:01:00Z", "2023-12-31T12:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expression+" from "+tt.from, func(t *testing.T) {
+			schedule, err := Parse(tt.expression)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			got := schedule.Next(mustTime(t, tt.from))
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("Next() = %v, want %v", got, want)
+			}
+			if !schedule.Matches(got) {
+				t.Errorf("Matches(%v) = false", got)
+			}
+		})
+	}
+}
+
+func TestNextNever(t *testing.T) {
+	schedule, err := Parse("0 0 30 2 *")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if got := schedule.Next(mustTime(t, "2022-11-07T00:00:00Z")); !got.IsZero() {
+		t.Errorf("Next() = %v, want zero time", got)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	schedule, err := Parse("0 2 * * 6")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	window := Window{Schedule: schedule, Duration: 2 * time.Hour}
+
+	tests := []struct {
+		at       string
+		open     bool
+		nextOpen string
+	}{
+		{"2022-11-12T01:59:59Z", false, "2022-11-12T02:00:00Z"},
+		{"2022-11-12T02:00:00Z", true, "2022-11-12T02:00:00Z"},
+		{"2022-11-12T03:59:30Z", true, "2022-11-12T03:59:30Z"},
+		{"2022-11-12T04:00:00Z", false, "2022-11-19T02:00:00Z"},
+		{"2022-11-15T12:00:00Z", false, "2022-11-19T02:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.at, func(t *testing.T) {
+			at := mustTime(t, tt.at)
+			if got := window.Open(at); got != tt.open {
+				t.Errorf("Open() = %v, want %v", got, tt.open)
+			}
+			if got, want := window.NextOpen(at), mustTime(t, tt.nextOpen); !got.Equal(want) {
+				t.Errorf("NextOpen() = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -70,7 +70,7 @@ func FalconCID(ctx context.Context, cid *string, fa *falcon.ApiConfig) (string,
 func FalconCloud(ctx context.Context, fa *falcon.ApiConfig) (falcon.CloudType, error) {
 	err := fa.Cloud.Autodiscover(ctx, fa.ClientId, fa.ClientSecret)
 	if err != nil {
-		return fa.Cloud, errorHint(err, "Could not autodiscover Falcon Cloud Region. Please provide your cloud_region in FalconContainer Spec")
+		return fa.Cloud, errorHint(err, "Could not autodiscover Falcon Cloud Region. Please provide your cloudRegion in the falconAPI spec")
 	}
 	return fa.Cloud, nil
 }
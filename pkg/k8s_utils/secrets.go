@@ -9,11 +9,11 @@ import (
 
 type KubeQuerySecretsMethod func(ctx context.Context) (*corev1.SecretList, error)
 
-func QuerySecretsInNamespace(cli client.Client, namespace string) KubeQuerySecretsMethod {
+func QuerySecretsInNamespace(cli client.Reader, namespace string) KubeQuerySecretsMethod {
 	return QuerySecrets(cli, client.InNamespace(namespace))
 }
 
-func QuerySecrets(cli client.Client, opts ...client.ListOption) KubeQuerySecretsMethod {
+func QuerySecrets(cli client.Reader, opts ...client.ListOption) KubeQuerySecretsMethod {
 	return func(ctx context.Context) (*corev1.SecretList, error) {
 		secrets := &corev1.SecretList{}
 		err := cli.List(ctx, secrets, opts...)
@@ -0,0 +1,89 @@
+package node
+
+import (
+	"context"
+	"fmt"
+
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/falcon_registry"
+	"github.com/go-logr/logr"
+)
+
+// SensorImage is the Falcon Sensor image deployed to the nodes of one architecture
+type SensorImage struct {
+	Architecture string
+	URI          string
+	Tag          string
+	Digest       string
+}
+
+// Architectures returns the node architectures the sensor is deployed to. The first one is the primary architecture:
+// its sensor version is the one selected by the requested version and the update policy.
+func (cc *ConfigCache) Architectures() []string {
+	return Architectures(cc.nodesensor)
+}
+
+// PrimaryArchitecture returns the node architecture of the image returned by GetImageURI
+func (cc *ConfigCache) PrimaryArchitecture() string {
+	return cc.Architectures()[0]
+}
+
+// GetSensorImages returns the sensor image of each node architecture, starting with the image returned by GetImageURI.
+// The other architectures run the newest build of the same sensor release, so that all nodes run the same sensor version.
+func (cc *ConfigCache) GetSensorImages(ctx context.Context, logger logr.Logger) ([]SensorImage, error) {
+	imageUri, err := cc.GetImageURI(ctx, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	archs := cc.Architectures()
+	images := []SensorImage{{Architecture: archs[0], URI: imageUri, Tag: cc.imageTag, Digest: cc.imageDigest}}
+	for _, arch := range archs[1:] {
+		image, tag, digest, err := resolveFalconImage(ctx, cc.client, cc.nodesensor, arch, sameRelease(cc.imageTag))
+		if err != nil {
+			return nil, fmt.Errorf("Failed to find the Falcon Sensor image for %s nodes: %v", arch, err)
+		}
+		if image != imageUri {
+			logger.Info("Identified Falcon Node Image", "architecture", arch, "reference", image)
+		}
+		images = append(images, SensorImage{Architecture: arch, URI: image, Tag: tag, Digest: digest})
+	}
+	return images, nil
+}
+
+// DaemonSetName returns the name of the DaemonSet deploying the sensor to the nodes of the architecture. The amd64
+// DaemonSet keeps the name of the FalconNodeSensor, as it did before other architectures were supported.
+func DaemonSetName(nodesensor *falconv1beta1.FalconNodeSensor, arch string) string {
+	if arch == string(falconv1beta1.NodeArchitectureAMD64) {
+		return nodesensor.Name
+	}
+	return fmt.Sprintf("%s-%s", nodesensor.Name, arch)
+}
+
+// Architectures returns the node architectures the FalconNodeSensor deploys the sensor to, starting with the primary architecture
+func Architectures(nodesensor *falconv1beta1.FalconNodeSensor) []string {
+	archs := []string{}
+	seen := map[string]bool{}
+	for _, arch := range nodesensor.Spec.Node.Architectures {
+		if arch == "" || seen[string(arch)] {
+			continue
+		}
+		seen[string(arch)] = true
+		archs = append(archs, string(arch))
+	}
+
+	if len(archs) == 0 {
+		return []string{string(falconv1beta1.NodeArchitectureAMD64)}
+	}
+	return archs
+}
+
+// sameRelease selects the builds of the sensor release of the tag, or any release when the tag carries no version
+func sameRelease(tag string) *string {
+	version, err := falcon_registry.ParseSensorVersion(tag)
+	if err != nil {
+		return nil
+	}
+	release := fmt.Sprintf("%d.%d.%d", version.Major, version.Minor, version.Patch)
+	return &release
+}
@@ -0,0 +1,54 @@
+package node
+
+import (
+	"testing"
+
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestArchitectures(t *testing.T) {
+	tests := []struct {
+		name  string
+		archs []falconv1beta1.NodeArchitecture
+		want  []string
+	}{
+		{"default", nil, []string{"amd64"}},
+		{"arm64 only", []falconv1beta1.NodeArchitecture{"arm64"}, []string{"arm64"}},
+		{"order kept without duplicates", []falconv1beta1.NodeArchitecture{"arm64", "amd64", "arm64"}, []string{"arm64", "amd64"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodesensor := &falconv1beta1.FalconNodeSensor{}
+			nodesensor.Spec.Node.Architectures = tt.archs
+
+			if diff := cmp.Diff(tt.want, Architectures(nodesensor)); diff != "" {
+				t.Errorf("Architectures() mismatch (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestDaemonSetName(t *testing.T) {
+	nodesensor := &falconv1beta1.FalconNodeSensor{}
+	nodesensor.Name = "falcon-node-sensor"
+
+	if got := DaemonSetName(nodesensor, "amd64"); got != "falcon-node-sensor" {
+		t.Errorf("DaemonSetName() = %s, want falcon-node-sensor", got)
+	}
+	if got := DaemonSetName(nodesensor, "arm64"); got != "falcon-node-sensor-arm64" {
+		t.Errorf("DaemonSetName() = %s, want falcon-node-sensor-arm64", got)
+	}
+}
+
+func TestSameRelease(t *testing.T) {
+	got := sameRelease("7.01.0-15604.falcon-linux.x86_64.Release.US-1")
+	if got == nil || *got != "7.1.0" {
+		t.Errorf("sameRelease() = %v, want 7.1.0", got)
+	}
+
+	if got := sameRelease("latest"); got != nil {
+		t.Errorf("sameRelease() = %s, want nil", *got)
+	}
+}
@@ -3,7 +3,7 @@ package assets
 import (
 	"testing"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/crowdstrike/falcon-operator/pkg/node"
 	"github.com/google/go-cmp/cmp"
@@ -12,7 +12,7 @@ import (
 )
 
 func TestDaemonsetConfigMap(t *testing.T) {
-	falconNode := v1alpha1.FalconNodeSensor{}
+	falconNode := v1beta1.FalconNodeSensor{}
 	falconNode.Spec.FalconAPI = nil
 	falconCID := "1234567890ABCDEF1234567890ABCDEF-12"
 	falconImage := "testMyImage"
@@ -3,14 +3,14 @@ package assets
 import (
 	"reflect"
 
-	falconv1alpha1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-func getTermGracePeriod(node *falconv1alpha1.FalconNodeSensor) *int64 {
+func getTermGracePeriod(node *falconv1beta1.FalconNodeSensor) *int64 {
 	gracePeriod := node.Spec.Node.TerminationGracePeriod
 	if gracePeriod < 10 {
 		gracePeriod = 10
@@ -20,15 +20,25 @@ func getTermGracePeriod(node *falconv1alpha1.FalconNodeSensor) *int64 {
 
 }
 
-func nodeAffinity(node *falconv1alpha1.FalconNodeSensor) *corev1.Affinity {
+func nodeAffinity(node *falconv1beta1.FalconNodeSensor) *corev1.Affinity {
 	if !reflect.DeepEqual(node.Spec.Node.NodeAffinity, corev1.NodeAffinity{}) {
 		return &corev1.Affinity{NodeAffinity: &node.Spec.Node.NodeAffinity}
 	}
 	return &corev1.Affinity{}
 }
 
-func pullSecrets(node *falconv1alpha1.FalconNodeSensor) []corev1.LocalObjectReference {
-	if node.Spec.Node.Image == "" {
+// nodeSelector restricts the DaemonSet to linux nodes of the given architecture
+func nodeSelector(arch string) map[string]string {
+	selector := map[string]string{common.NodeArchitectureLabel: arch}
+	for key, value := range common.NodeSelector {
+		selector[key] = value
+	}
+	return selector
+}
+
+func pullSecrets(node *falconv1beta1.FalconNodeSensor) []corev1.LocalObjectReference {
+	// Mirrored images are pulled with the pull secrets of the user, or the node credentials of the cloud provider
+	if node.Spec.Node.Image == "" && !node.MirrorsImage() {
 		return []corev1.LocalObjectReference{
 			{
 				Name: common.FalconPullSecretName,
@@ -39,18 +49,18 @@ func pullSecrets(node *falconv1alpha1.FalconNodeSensor) []corev1.LocalObjectRefe
 	}
 }
 
-func dsUpdateStrategy(node *falconv1alpha1.FalconNodeSensor) appsv1.DaemonSetUpdateStrategy {
-	if node.Spec.Node.DSUpdateStrategy.Type == appsv1.RollingUpdateDaemonSetStrategyType || node.Spec.Node.DSUpdateStrategy.Type == "" {
+func dsUpdateStrategy(node *falconv1beta1.FalconNodeSensor) appsv1.DaemonSetUpdateStrategy {
+	if node.Spec.Node.UpdateStrategy.Type == appsv1.RollingUpdateDaemonSetStrategyType || node.Spec.Node.UpdateStrategy.Type == "" {
 		rollingUpdateSettings := appsv1.RollingUpdateDaemonSet{}
 
 		/* Beta feature to enable later
-		if node.Spec.Node.DSUpdateStrategy.RollingUpdate.MaxSurge != nil {
-			rollingUpdateSettings.MaxSurge = node.Spec.Node.DSUpdateStrategy.RollingUpdate.MaxSurge
+		if node.Spec.Node.UpdateStrategy.RollingUpdate.MaxSurge != nil {
+			rollingUpdateSettings.MaxSurge = node.Spec.Node.UpdateStrategy.RollingUpdate.MaxSurge
 		}
 		*/
 
-		if node.Spec.Node.DSUpdateStrategy.RollingUpdate.MaxUnavailable != nil {
-			rollingUpdateSettings.MaxUnavailable = node.Spec.Node.DSUpdateStrategy.RollingUpdate.MaxUnavailable
+		if node.Spec.Node.UpdateStrategy.RollingUpdate.MaxUnavailable != nil {
+			rollingUpdateSettings.MaxUnavailable = node.Spec.Node.UpdateStrategy.RollingUpdate.MaxUnavailable
 		}
 
 		return appsv1.DaemonSetUpdateStrategy{
@@ -62,7 +72,7 @@ func dsUpdateStrategy(node *falconv1alpha1.FalconNodeSensor) appsv1.DaemonSetUpd
 	return appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
 }
 
-func Daemonset(dsName, image, serviceAccount string, node *falconv1alpha1.FalconNodeSensor) *appsv1.DaemonSet {
+func Daemonset(dsName, image, arch, serviceAccount string, node *falconv1beta1.FalconNodeSensor) *appsv1.DaemonSet {
 	privileged := true
 	escalation := true
 	readOnlyFs := false
@@ -93,7 +103,7 @@ func Daemonset(dsName, image, serviceAccount string, node *falconv1alpha1.Falcon
 				},
 				Spec: corev1.PodSpec{
 					// NodeSelector is set to linux until windows containers are supported for the Falcon sensor
-					NodeSelector:                  common.NodeSelector,
+					NodeSelector:                  nodeSelector(arch),
 					Affinity:                      nodeAffinity(node),
 					Tolerations:                   node.Spec.Node.Tolerations,
 					HostPID:                       hostpid,
@@ -137,7 +147,7 @@ func Daemonset(dsName, image, serviceAccount string, node *falconv1alpha1.Falcon
 								{
 									ConfigMapRef: &corev1.ConfigMapEnvSource{
 										LocalObjectReference: corev1.LocalObjectReference{
-											Name: dsName + "-config",
+											Name: node.Name + "-config",
 										},
 									},
 								},
@@ -176,7 +186,7 @@ func Daemonset(dsName, image, serviceAccount string, node *falconv1alpha1.Falcon
 	}
 }
 
-func RemoveNodeDirDaemonset(dsName, image, serviceAccount string, node *falconv1alpha1.FalconNodeSensor) *appsv1.DaemonSet {
+func RemoveNodeDirDaemonset(dsName, image, arch, serviceAccount string, node *falconv1beta1.FalconNodeSensor) *appsv1.DaemonSet {
 	privileged := true
 	escalation := true
 	readOnlyFs := false
@@ -202,7 +212,7 @@ func RemoveNodeDirDaemonset(dsName, image, serviceAccount string, node *falconv1
 				},
 				Spec: corev1.PodSpec{
 					// NodeSelector is set to linux until windows containers are supported for the Falcon sensor
-					NodeSelector:                  common.NodeSelector,
+					NodeSelector:                  nodeSelector(arch),
 					Affinity:                      nodeAffinity(node),
 					Tolerations:                   node.Spec.Node.Tolerations,
 					HostPID:                       hostpid,
@@ -210,7 +220,7 @@ func RemoveNodeDirDaemonset(dsName, image, serviceAccount string, node *falconv1
 					ImagePullSecrets:              pullSecrets(node),
 					InitContainers: []corev1.Container{
 						{
-							Name:    "cleanup-opt-crowdstrike",
+							Name:    common.FalconCleanupInitContainerName,
 							Image:   image,
 							Command: common.FalconShellCommand,
 							Args:    common.InitCleanupArgs(),
@@ -3,7 +3,7 @@ package assets
 import (
 	"testing"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/google/go-cmp/cmp"
 	appsv1 "k8s.io/api/apps/v1"
@@ -13,7 +13,7 @@ import (
 )
 
 func TestGetTermGracePeriod(t *testing.T) {
-	falconNode := v1alpha1.FalconNodeSensor{}
+	falconNode := v1beta1.FalconNodeSensor{}
 	falconNode.Spec.FalconAPI = nil
 
 	want := int64(10)
@@ -30,7 +30,7 @@ func TestGetTermGracePeriod(t *testing.T) {
 }
 
 func TestNodeAffinity(t *testing.T) {
-	falconNode := v1alpha1.FalconNodeSensor{}
+	falconNode := v1beta1.FalconNodeSensor{}
 
 	got := nodeAffinity(&falconNode)
 	want := &corev1.Affinity{}
@@ -63,8 +63,21 @@ func TestNodeAffinity(t *testing.T) {
 	}
 }
 
+func TestNodeSelector(t *testing.T) {
+	want := map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "arm64"}
+	got := nodeSelector("arm64")
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("nodeSelector() mismatch (-want +got): %s", diff)
+	}
+
+	// The shared linux node selector is left untouched
+	if diff := cmp.Diff(map[string]string{"kubernetes.io/os": "linux"}, common.NodeSelector); diff != "" {
+		t.Errorf("nodeSelector() changed common.NodeSelector (-want +got): %s", diff)
+	}
+}
+
 func TestPullSecrets(t *testing.T) {
-	falconNode := v1alpha1.FalconNodeSensor{}
+	falconNode := v1beta1.FalconNodeSensor{}
 
 	want := []corev1.LocalObjectReference{
 		{
@@ -90,13 +103,23 @@ func TestPullSecrets(t *testing.T) {
 	if diff := cmp.Diff(want, got); diff != "" {
 		t.Errorf("PullSecrets() mismatch (-want +got): %s", diff)
 	}
+
+	// Mirrored images are not pulled with the CrowdStrike pull secret
+	falconNode.Spec.Node.Image = ""
+	falconNode.Spec.FalconAPI = &v1beta1.FalconAPI{}
+	falconNode.Spec.Registry = &v1beta1.RegistrySpec{Type: v1beta1.RegistryTypeECR}
+
+	got = pullSecrets(&falconNode)
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("PullSecrets() mismatch (-want +got): %s", diff)
+	}
 }
 
 func TestDsUpdateStrategy(t *testing.T) {
-	falconNode := v1alpha1.FalconNodeSensor{}
+	falconNode := v1beta1.FalconNodeSensor{}
 
 	// Test OnDelete return value
-	falconNode.Spec.Node.DSUpdateStrategy.Type = "OnDelete"
+	falconNode.Spec.Node.UpdateStrategy.Type = "OnDelete"
 	got := dsUpdateStrategy(&falconNode)
 	want := appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
 
@@ -115,8 +138,8 @@ func TestDsUpdateStrategy(t *testing.T) {
 		},
 	}
 
-	falconNode.Spec.Node.DSUpdateStrategy.Type = appsv1.RollingUpdateDaemonSetStrategyType
-	falconNode.Spec.Node.DSUpdateStrategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{Type: intstr.Int, IntVal: 1}
+	falconNode.Spec.Node.UpdateStrategy.Type = appsv1.RollingUpdateDaemonSetStrategyType
+	falconNode.Spec.Node.UpdateStrategy.RollingUpdate.MaxUnavailable = &intstr.IntOrString{Type: intstr.Int, IntVal: 1}
 
 	got = dsUpdateStrategy(&falconNode)
 	if diff := cmp.Diff(want, got); diff != "" {
@@ -125,7 +148,7 @@ func TestDsUpdateStrategy(t *testing.T) {
 }
 
 func TestDaemonset(t *testing.T) {
-	falconNode := v1alpha1.FalconNodeSensor{}
+	falconNode := v1beta1.FalconNodeSensor{}
 	falconNode.Namespace = "falcon-system"
 	falconNode.Name = "test"
 	image := "testImage"
@@ -159,7 +182,7 @@ func TestDaemonset(t *testing.T) {
 				},
 				Spec: corev1.PodSpec{
 					// NodeSelector is set to linux until windows containers are supported for the Falcon sensor
-					NodeSelector:                  common.NodeSelector,
+					NodeSelector:                  map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "amd64"},
 					Affinity:                      nodeAffinity(&falconNode),
 					Tolerations:                   falconNode.Spec.Node.Tolerations,
 					HostPID:                       hostpid,
@@ -203,7 +226,7 @@ func TestDaemonset(t *testing.T) {
 								{
 									ConfigMapRef: &corev1.ConfigMapEnvSource{
 										LocalObjectReference: corev1.LocalObjectReference{
-											Name: dsName + "-config",
+											Name: falconNode.Name + "-config",
 										},
 									},
 								},
@@ -241,14 +264,14 @@ func TestDaemonset(t *testing.T) {
 		},
 	}
 
-	got := Daemonset(dsName, image, common.NodeServiceAccountName, &falconNode)
+	got := Daemonset(dsName, image, "amd64", common.NodeServiceAccountName, &falconNode)
 	if diff := cmp.Diff(want, got); diff != "" {
 		t.Errorf("Daemonset() mismatch (-want +got): %s", diff)
 	}
 }
 
 func TestRemoveNodeDirDaemonset(t *testing.T) {
-	falconNode := v1alpha1.FalconNodeSensor{}
+	falconNode := v1beta1.FalconNodeSensor{}
 	falconNode.Namespace = "falcon-system"
 	falconNode.Name = "test"
 	image := "testImage"
@@ -277,7 +300,7 @@ func TestRemoveNodeDirDaemonset(t *testing.T) {
 				},
 				Spec: corev1.PodSpec{
 					// NodeSelector is set to linux until windows containers are supported for the Falcon sensor
-					NodeSelector:                  common.NodeSelector,
+					NodeSelector:                  map[string]string{"kubernetes.io/os": "linux", "kubernetes.io/arch": "amd64"},
 					Affinity:                      nodeAffinity(&falconNode),
 					Tolerations:                   falconNode.Spec.Node.Tolerations,
 					HostPID:                       hostpid,
@@ -333,7 +356,7 @@ func TestRemoveNodeDirDaemonset(t *testing.T) {
 		},
 	}
 
-	got := RemoveNodeDirDaemonset(dsName, image, common.NodeServiceAccountName, &falconNode)
+	got := RemoveNodeDirDaemonset(dsName, image, "amd64", common.NodeServiceAccountName, &falconNode)
 	if diff := cmp.Diff(want, got); diff != "" {
 		t.Errorf("Daemonset() mismatch (-want +got): %s", diff)
 	}
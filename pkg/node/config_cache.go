@@ -4,21 +4,30 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strings"
 
-	falconv1alpha1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/types"
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/crowdstrike/falcon-operator/pkg/common"
 	"github.com/crowdstrike/falcon-operator/pkg/falcon_api"
+	"github.com/crowdstrike/falcon-operator/pkg/registry"
 	"github.com/crowdstrike/falcon-operator/pkg/registry/falcon_registry"
 	"github.com/crowdstrike/falcon-operator/pkg/registry/pulltoken"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/verification"
 	"github.com/crowdstrike/gofalcon/falcon"
 	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // ConfigCache holds config values for node sensor. Those values are either provided by user or fetched dynamically. That happens transparently to the caller.
 type ConfigCache struct {
-	cid        string
-	imageUri   string
-	nodesensor *falconv1alpha1.FalconNodeSensor
+	cid         string
+	imageUri    string
+	imageTag    string
+	imageDigest string
+	nodesensor  *falconv1beta1.FalconNodeSensor
+	client      client.Reader
 }
 
 func (cc *ConfigCache) CID() string {
@@ -35,7 +44,7 @@ func (cc *ConfigCache) UsingCrowdStrikeRegistry() bool {
 func (cc *ConfigCache) GetImageURI(ctx context.Context, logger logr.Logger) (string, error) {
 	var err error
 	if cc.imageUri == "" {
-		cc.imageUri, err = getFalconImage(ctx, cc.nodesensor)
+		cc.imageUri, cc.imageTag, cc.imageDigest, err = resolveFalconImage(ctx, cc.client, cc.nodesensor, cc.PrimaryArchitecture(), requestedVersion(cc.nodesensor))
 		if err == nil {
 			logger.Info("Identified Falcon Node Image", "reference", cc.imageUri)
 		}
@@ -43,11 +52,61 @@ func (cc *ConfigCache) GetImageURI(ctx context.Context, logger logr.Logger) (str
 	return cc.imageUri, err
 }
 
+// ImageTag returns the tag of the image returned by GetImageURI, or an empty string when the image is referenced by digest only
+func (cc *ConfigCache) ImageTag() string {
+	return cc.imageTag
+}
+
+// ImageDigest returns the manifest digest of the image returned by GetImageURI, or an empty string when it is not known
+func (cc *ConfigCache) ImageDigest() string {
+	return cc.imageDigest
+}
+
 func (cc *ConfigCache) GetPullToken(ctx context.Context) ([]byte, error) {
 	if cc.nodesensor.Spec.FalconAPI == nil {
-		return nil, fmt.Errorf("Missing falcon_api configuration")
+		return nil, fmt.Errorf("Missing falconAPI configuration")
+	}
+	apiConfig, err := cc.nodesensor.Spec.FalconAPI.ApiConfig(ctx, cc.client)
+	if err != nil {
+		return nil, err
 	}
-	return pulltoken.CrowdStrike(ctx, cc.nodesensor.Spec.FalconAPI.ApiConfig())
+	return pulltoken.CrowdStrike(ctx, apiConfig)
+}
+
+// VerifyImage checks the signature of the sensor image against the image verification settings. Mirrored images carry the
+// signatures made for the CrowdStrike registry. Images outside of the CrowdStrike registry and the mirror are read anonymously.
+func (cc *ConfigCache) VerifyImage(ctx context.Context, image string) error {
+	if !verification.Enabled(cc.nodesensor.Spec.ImageVerification) {
+		return nil
+	}
+
+	ref, err := docker.ParseReference("//" + image)
+	if err != nil {
+		return fmt.Errorf("Invalid image reference %s: %v", image, err)
+	}
+
+	var sys *types.SystemContext
+	signedRepository := ""
+	switch {
+	case cc.ImageMirroringEnabled():
+		sys, err = cc.mirrorSystemContext(ctx)
+		if err != nil {
+			return err
+		}
+		cloud, err := cc.nodesensor.Spec.FalconAPI.FalconCloud(ctx, cc.client)
+		if err != nil {
+			return err
+		}
+		signedRepository = falcon_registry.ImageURINode(cloud)
+	case cc.UsingCrowdStrikeRegistry():
+		sys, err = cc.falconRegistrySystemContext(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = verification.Verify(ctx, cc.nodesensor.Spec.ImageVerification, ref, sys, signedRepository)
+	return err
 }
 
 func (cc *ConfigCache) SensorEnvVars() map[string]string {
@@ -58,18 +117,27 @@ func (cc *ConfigCache) SensorEnvVars() map[string]string {
 	if cc.nodesensor.Spec.Node.Backend != "" {
 		sensorConfig["FALCONCTL_OPT_BACKEND"] = cc.nodesensor.Spec.Node.Backend
 	}
+	if cc.nodesensor.Spec.Node.AdditionalEnvironmentVariables != nil {
+		for k, v := range *cc.nodesensor.Spec.Node.AdditionalEnvironmentVariables {
+			sensorConfig[strings.ToUpper(k)] = v
+		}
+	}
 	return sensorConfig
 }
 
-func NewConfigCache(ctx context.Context, logger logr.Logger, nodesensor *falconv1alpha1.FalconNodeSensor) (*ConfigCache, error) {
+func NewConfigCache(ctx context.Context, logger logr.Logger, cli client.Reader, nodesensor *falconv1beta1.FalconNodeSensor) (*ConfigCache, error) {
 	var apiConfig *falcon.ApiConfig
 	var err error
 	cache := ConfigCache{
 		nodesensor: nodesensor,
+		client:     cli,
 	}
 
 	if nodesensor.Spec.FalconAPI != nil {
-		apiConfig = nodesensor.Spec.FalconAPI.ApiConfig()
+		apiConfig, err = nodesensor.Spec.FalconAPI.ApiConfig(ctx, cli)
+		if err != nil {
+			return nil, err
+		}
 		if nodesensor.Spec.FalconAPI.CID != nil {
 			cache.cid = *nodesensor.Spec.FalconAPI.CID
 		}
@@ -85,39 +153,67 @@ func NewConfigCache(ctx context.Context, logger logr.Logger, nodesensor *falconv
 	return &cache, nil
 }
 
-func getFalconImage(ctx context.Context, nodesensor *falconv1alpha1.FalconNodeSensor) (string, error) {
+func getFalconImage(ctx context.Context, cli client.Reader, nodesensor *falconv1beta1.FalconNodeSensor) (string, error) {
+	image, _, _, err := resolveFalconImage(ctx, cli, nodesensor, Architectures(nodesensor)[0], requestedVersion(nodesensor))
+	return image, err
+}
+
+// resolveFalconImage returns the sensor image for the node architecture along with its tag and digest. Images from the CrowdStrike
+// registry are pinned to the digest the selected tag points to, so that a tag pushed again does not change what runs on the nodes.
+// Images set by the user are expected to be multi-arch images and are used for every architecture.
+func resolveFalconImage(ctx context.Context, cli client.Reader, nodesensor *falconv1beta1.FalconNodeSensor, arch string, versionRequested *string) (image string, imageTag string, imageDigest string, err error) {
 	if nodesensor.Spec.Node.Image != "" {
-		return nodesensor.Spec.Node.Image, nil
+		imageTag, imageDigest = registry.SplitImage(nodesensor.Spec.Node.Image)
+		return nodesensor.Spec.Node.Image, imageTag, imageDigest, nil
 	}
 
 	nodeImage := os.Getenv("RELATED_IMAGE_NODE_SENSOR")
 	if nodeImage != "" && nodesensor.Spec.FalconAPI == nil {
-		return nodeImage, nil
+		imageTag, imageDigest = registry.SplitImage(nodeImage)
+		return nodeImage, imageTag, imageDigest, nil
 	}
 
 	if nodesensor.Spec.FalconAPI == nil {
-		return "", fmt.Errorf("Missing falcon_api configuration")
+		return "", "", "", fmt.Errorf("Missing falconAPI configuration")
 	}
 
-	cloud, err := nodesensor.Spec.FalconAPI.FalconCloud(ctx)
+	cloud, err := nodesensor.Spec.FalconAPI.FalconCloud(ctx, cli)
 	if err != nil {
-		return "", err
+		return "", "", "", err
 	}
 	imageUri := falcon_registry.ImageURINode(cloud)
 
-	registry, err := falcon_registry.NewFalconRegistry(ctx, nodesensor.Spec.FalconAPI.ApiConfig())
+	apiConfig, err := nodesensor.Spec.FalconAPI.ApiConfig(ctx, cli)
 	if err != nil {
-		return "", err
+		return "", "", "", err
 	}
-	imageTag, err := registry.LastNodeTag(ctx, nodesensor.Spec.Node.Version)
+	falconRegistry, err := falcon_registry.NewFalconRegistry(ctx, apiConfig)
 	if err != nil {
-		return "", err
+		return "", "", "", err
+	}
+	imageTag, err = falconRegistry.LastNodeTag(ctx, arch, versionRequested)
+	if err != nil {
+		return "", "", "", err
 	}
 
-	return fmt.Sprintf("%s:%s", imageUri, imageTag), nil
+	sys, err := falconRegistry.SystemContext()
+	if err != nil {
+		return "", "", "", err
+	}
+	image = fmt.Sprintf("%s:%s", imageUri, imageTag)
+	imageDigest, err = registry.ImageDigest(ctx, sys, image)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	image, err = registry.PinImage(image, imageDigest)
+	if err != nil {
+		return "", "", "", err
+	}
+	return image, imageTag, imageDigest, nil
 }
 
-func ConfigCacheTest(cid string, imageUri string, nodeTest *falconv1alpha1.FalconNodeSensor) *ConfigCache {
+func ConfigCacheTest(cid string, imageUri string, nodeTest *falconv1beta1.FalconNodeSensor) *ConfigCache {
 	return &ConfigCache{
 		cid:        cid,
 		imageUri:   imageUri,
@@ -7,12 +7,12 @@ import (
 	"strings"
 	"testing"
 
-	"github.com/crowdstrike/falcon-operator/apis/falcon/v1alpha1"
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
 	"github.com/go-logr/logr"
 	"github.com/google/go-cmp/cmp"
 )
 
-var falconNode = v1alpha1.FalconNodeSensor{}
+var falconNode = v1beta1.FalconNodeSensor{}
 var falconCID = "1234567890ABCDEF1234567890ABCDEF-12"
 var falconImage = "testMyImage"
 var config = ConfigCache{cid: falconCID, imageUri: falconImage, nodesensor: &falconNode}
@@ -50,7 +50,7 @@ func TestGetImageURI(t *testing.T) {
 	config.imageUri = ""
 	got, err := config.GetImageURI(context.Background(), logger)
 	if err != nil {
-		if err.Error() != "Missing falcon_api configuration" {
+		if err.Error() != "Missing falconAPI configuration" {
 			t.Errorf("GetImageURI() error: %v", err)
 		}
 	}
@@ -75,7 +75,7 @@ func TestGetImageURI(t *testing.T) {
 func TestGetPullToken(t *testing.T) {
 	got, err := config.GetPullToken(context.Background())
 	if err != nil {
-		if err.Error() != "Missing falcon_api configuration" {
+		if err.Error() != "Missing falconAPI configuration" {
 			t.Errorf("GetPullToken() error: %v", err)
 		}
 	}
@@ -83,8 +83,8 @@ func TestGetPullToken(t *testing.T) {
 		t.Errorf("GetPullToken() = %s, want %s", got, "not empty")
 	}
 
-	config.nodesensor.Spec.FalconAPI = &v1alpha1.FalconAPI{
-		ClientId:     "testID",
+	config.nodesensor.Spec.FalconAPI = &v1beta1.FalconAPI{
+		ClientID:     "testID",
 		ClientSecret: "testSecret",
 		CloudRegion:  "testRegion",
 	}
@@ -114,6 +114,16 @@ func TestSensorEnvVars(t *testing.T) {
 	if diff := cmp.Diff(want, got); diff != "" {
 		t.Errorf("SensorEnvVars() mismatch (-want +got): %s", diff)
 	}
+
+	// Additional environment variables are upper-cased and take precedence
+	config.nodesensor.Spec.Node.AdditionalEnvironmentVariables = &map[string]string{"falconctl_opt_backend": "bpf", "FALCONCTL_OPT_MAINTENANCE_TOKEN": "token"}
+	want["FALCONCTL_OPT_BACKEND"] = "bpf"
+	want["FALCONCTL_OPT_MAINTENANCE_TOKEN"] = "token"
+	got = config.SensorEnvVars()
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SensorEnvVars() mismatch (-want +got): %s", diff)
+	}
+	config.nodesensor.Spec.Node.AdditionalEnvironmentVariables = nil
 }
 
 func TestNewConfigCache(t *testing.T) {
@@ -123,7 +133,7 @@ func TestNewConfigCache(t *testing.T) {
 	falconNode.Spec.FalconAPI = nil
 	falconNode.Spec.Falcon.CID = &falconCID
 
-	newCache, err := NewConfigCache(context.Background(), logger, &falconNode)
+	newCache, err := NewConfigCache(context.Background(), logger, nil, &falconNode)
 	if err != nil {
 		t.Errorf("NewConfigCache() error: %v", err)
 	}
@@ -132,13 +142,13 @@ func TestNewConfigCache(t *testing.T) {
 		t.Errorf("NewConfigCache() = %v, want %v", newCache, want)
 	}
 
-	config.nodesensor.Spec.FalconAPI = &v1alpha1.FalconAPI{
-		ClientId:     "testID",
+	config.nodesensor.Spec.FalconAPI = &v1beta1.FalconAPI{
+		ClientID:     "testID",
 		ClientSecret: "testSecret",
 		CloudRegion:  "testRegion",
 		CID:          &falconCID,
 	}
-	newCache, err = NewConfigCache(context.Background(), logger, &falconNode)
+	newCache, err = NewConfigCache(context.Background(), logger, nil, &falconNode)
 	if err != nil {
 		t.Errorf("NewConfigCache() error: %v", err)
 	}
@@ -158,8 +168,8 @@ func TestConfigCacheTest(t *testing.T) {
 }
 
 func TestGetFalconImage(t *testing.T) {
-	falconNode.Spec.FalconAPI = &v1alpha1.FalconAPI{
-		ClientId:     "testID",
+	falconNode.Spec.FalconAPI = &v1beta1.FalconAPI{
+		ClientID:     "testID",
 		ClientSecret: "testSecret",
 		CloudRegion:  "testRegion",
 		CID:          &falconCID,
@@ -167,7 +177,7 @@ func TestGetFalconImage(t *testing.T) {
 
 	testVersion := "testVersion"
 	falconNode.Spec.Node.Version = &testVersion
-	got, err := getFalconImage(context.Background(), &falconNode)
+	got, err := getFalconImage(context.Background(), nil, &falconNode)
 	if err != nil {
 		if strings.Contains(err.Error(), "401 Unauthorized") {
 			got = fmt.Sprintf("%s:%s", "TestImageEnv", *falconNode.Spec.Node.Version)
@@ -179,9 +189,9 @@ func TestGetFalconImage(t *testing.T) {
 	}
 
 	falconNode.Spec.FalconAPI = nil
-	_, err = getFalconImage(context.Background(), &falconNode)
+	_, err = getFalconImage(context.Background(), nil, &falconNode)
 	if err != nil {
-		if err.Error() != "Missing falcon_api configuration" {
+		if err.Error() != "Missing falconAPI configuration" {
 			t.Errorf("getFalconImage() error: %v", err)
 		}
 	}
@@ -192,7 +202,7 @@ func TestGetFalconImage(t *testing.T) {
 	}
 
 	want := "TestImageEnv"
-	got, err = getFalconImage(context.Background(), &falconNode)
+	got, err = getFalconImage(context.Background(), nil, &falconNode)
 	if err != nil {
 		t.Errorf("getFalconImage() error: %v", err)
 	}
@@ -203,7 +213,7 @@ func TestGetFalconImage(t *testing.T) {
 	want = "TestImageOverride"
 	falconNode.Spec.Node.Image = want
 
-	got, err = getFalconImage(context.Background(), &falconNode)
+	got, err = getFalconImage(context.Background(), nil, &falconNode)
 	if err != nil {
 		t.Errorf("getFalconImage() error: %v", err)
 	}
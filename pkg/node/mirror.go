@@ -0,0 +1,167 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/types"
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/aws"
+	"github.com/crowdstrike/falcon-operator/pkg/gcp"
+	"github.com/crowdstrike/falcon-operator/pkg/registry"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/falcon_registry"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/mirror"
+	"github.com/go-logr/logr"
+	imagev1 "github.com/openshift/api/image/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// ImageStreamName is the OpenShift ImageStream the sensor image is mirrored to
+	ImageStreamName = "falcon-node-sensor"
+	// mirrorRepositoryName is the repository the sensor image is mirrored to in ECR, GCR and ACR
+	mirrorRepositoryName = "falcon-sensor"
+)
+
+// ImageMirroringEnabled reports whether the sensor images are mirrored to the registry of spec.registry before they are deployed
+func (cc *ConfigCache) ImageMirroringEnabled() bool {
+	return cc.nodesensor.MirrorsImage()
+}
+
+// ImageNamespace returns the namespace holding the credentials for pushing to the registry and, on OpenShift, the ImageStream
+func (cc *ConfigCache) ImageNamespace() string {
+	if cc.nodesensor.Spec.Registry != nil && cc.nodesensor.Spec.Registry.Type == falconv1beta1.RegistryTypeOpenshift {
+		// Images pushed to the shared "openshift" namespace can be pulled from the other namespaces
+		return "openshift"
+	}
+	return cc.nodesensor.TargetNs()
+}
+
+// MirrorImages copies the sensor image of each node architecture to the registry of spec.registry and returns the images
+// the DaemonSets deploy instead, pinned to the digest of the mirrored copy. Images already mirrored are not copied again.
+func (cc *ConfigCache) MirrorImages(ctx context.Context, logger logr.Logger, images []SensorImage) ([]SensorImage, error) {
+	if !cc.ImageMirroringEnabled() {
+		return images, nil
+	}
+
+	repository, err := cc.mirrorRepository(ctx)
+	if err != nil {
+		return nil, err
+	}
+	destinationCtx, err := cc.mirrorSystemContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sourceCtx, err := cc.falconRegistrySystemContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	mirrored := make([]SensorImage, 0, len(images))
+	for _, image := range images {
+		destination := fmt.Sprintf("%s:%s", repository, image.Tag)
+		digest, err := registry.ImageDigest(ctx, destinationCtx, destination)
+		if err != nil || digest != image.Digest {
+			srcRef, err := docker.ParseReference("//" + image.URI)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid image reference %s: %v", image.URI, err)
+			}
+
+			logger.Info("Mirroring Falcon Node Image", "architecture", image.Architecture, "source", image.URI, "destination", destination)
+			err = mirror.Copy(ctx, logger, srcRef, sourceCtx, destination, destinationCtx, cc.nodesensor.Spec.ImageVerification)
+			if err != nil {
+				return nil, fmt.Errorf("Cannot mirror Falcon Node Image for %s nodes: %v", image.Architecture, err)
+			}
+
+			digest, err = registry.ImageDigest(ctx, destinationCtx, destination)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		uri, err := registry.PinImage(destination, digest)
+		if err != nil {
+			return nil, err
+		}
+		mirrored = append(mirrored, SensorImage{Architecture: image.Architecture, URI: uri, Tag: image.Tag, Digest: digest})
+	}
+	return mirrored, nil
+}
+
+// mirrorRepository returns the repository of spec.registry the sensor image is mirrored to
+func (cc *ConfigCache) mirrorRepository(ctx context.Context) (string, error) {
+	spec := cc.nodesensor.Spec.Registry
+	switch spec.Type {
+	case falconv1beta1.RegistryTypeOpenshift:
+		imageStream := &imagev1.ImageStream{}
+		err := cc.client.Get(ctx, k8stypes.NamespacedName{Name: ImageStreamName, Namespace: cc.ImageNamespace()}, imageStream)
+		if err != nil {
+			return "", err
+		}
+
+		if imageStream.Status.DockerImageRepository == "" {
+			return "", fmt.Errorf("Unable to find route to OpenShift on-cluster registry. Please verify that OpenShift on-cluster registry is up and running.")
+		}
+
+		return imageStream.Status.DockerImageRepository, nil
+	case falconv1beta1.RegistryTypeGCR:
+		projectId, err := gcp.GetProjectID()
+		if err != nil {
+			return "", fmt.Errorf("Cannot get GCP Project ID: %v", err)
+		}
+
+		return "gcr.io/" + projectId + "/" + mirrorRepositoryName, nil
+	case falconv1beta1.RegistryTypeECR:
+		cfg, err := aws.NewConfig()
+		if err != nil {
+			return "", fmt.Errorf("Failed to initialise connection to AWS. Please make sure that kubernetes service account falcon-operator has access to AWS IAM role and OIDC Identity provider is running on the cluster. Error was: %v", err)
+		}
+
+		repo, _, err := cfg.UpsertRepository(ctx, mirrorRepositoryName)
+		if err != nil {
+			return "", fmt.Errorf("Failed to upsert ECR repository: %v", err)
+		}
+
+		return *repo.RepositoryUri, nil
+	case falconv1beta1.RegistryTypeACR:
+		if spec.ACRName == nil {
+			return "", fmt.Errorf("Cannot push Falcon Image locally to ACR. acrName was not specified")
+		}
+
+		return fmt.Sprintf("%s.azurecr.io/%s", *spec.ACRName, mirrorRepositoryName), nil
+	case falconv1beta1.RegistryTypeGeneric:
+		return mirror.GenericRepository(spec.URI)
+	default:
+		return "", fmt.Errorf("Unrecognized registry type: %s", spec.Type)
+	}
+}
+
+// mirrorSystemContext returns the system context for pushing to and reading from the registry of spec.registry
+func (cc *ConfigCache) mirrorSystemContext(ctx context.Context) (*types.SystemContext, error) {
+	spec := cc.nodesensor.Spec.Registry
+	creds, err := mirror.Credentials(ctx, cc.client, spec, cc.ImageNamespace())
+	if err != nil {
+		return nil, err
+	}
+
+	certDir, err := mirror.CertDir(ctx, cc.client, spec.TLS, cc.nodesensor.TargetNs(), filepath.Join("falconnodesensor", cc.nodesensor.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	return mirror.DestinationContext(creds, spec.TLS, certDir)
+}
+
+func (cc *ConfigCache) falconRegistrySystemContext(ctx context.Context) (*types.SystemContext, error) {
+	apiConfig, err := cc.nodesensor.Spec.FalconAPI.ApiConfig(ctx, cc.client)
+	if err != nil {
+		return nil, err
+	}
+	falconRegistry, err := falcon_registry.NewFalconRegistry(ctx, apiConfig)
+	if err != nil {
+		return nil, err
+	}
+	return falconRegistry.SystemContext()
+}
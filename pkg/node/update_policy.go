@@ -0,0 +1,222 @@
+package node
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/cron"
+	"github.com/crowdstrike/falcon-operator/pkg/registry"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/falcon_registry"
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultCheckInterval     = time.Hour
+	defaultMaintenanceWindow = time.Hour
+)
+
+// ApplyUpdatePolicy decides whether the sensor image selected from the CrowdStrike registry is rolled out or whether the
+// nodes keep running the current version, and records a version held back by the policy in the status. It reports whether
+// the status changed and when the registry should be checked again.
+func (cc *ConfigCache) ApplyUpdatePolicy(ctx context.Context, logger logr.Logger, now time.Time) (bool, time.Duration, error) {
+	policy := cc.nodesensor.Spec.Node.UpdatePolicy
+	if policy == nil || !cc.UsingCrowdStrikeRegistry() {
+		return false, 0, nil
+	}
+
+	if _, err := cc.GetImageURI(ctx, logger); err != nil {
+		return false, 0, err
+	}
+
+	status := &cc.nodesensor.Status
+	pendingSensor, pendingSince := status.PendingSensor, status.PendingSince
+
+	// A sensor built for another architecture, installed before the first architecture changed, is replaced right away
+	installed := status.Sensor
+	if installed != nil && !falcon_registry.NodeTagMatchesArchitecture(*installed, cc.PrimaryArchitecture()) {
+		status.Sensor = nil
+	}
+	adopt, requeueAfter, err := decideUpdate(policy, status, cc.nodesensor.Spec.Node.Version, cc.imageTag, now)
+	status.Sensor = installed
+	if err != nil {
+		return false, 0, err
+	}
+
+	if !adopt {
+		if err := cc.useInstalledImage(ctx, *status.Sensor, status.ImageDigest); err != nil {
+			return false, 0, err
+		}
+		if status.PendingSensor != "" {
+			logger.Info("Holding back Falcon Sensor update as required by the update policy", "current", *status.Sensor, "pending", status.PendingSensor)
+		}
+	}
+
+	changed := status.PendingSensor != pendingSensor || !status.PendingSince.Equal(pendingSince)
+	return changed, requeueAfter, nil
+}
+
+// useInstalledImage makes GetImageURI return the sensor version that is already installed
+func (cc *ConfigCache) useInstalledImage(ctx context.Context, tag, digest string) error {
+	cloud, err := cc.nodesensor.Spec.FalconAPI.FalconCloud(ctx, cc.client)
+	if err != nil {
+		return err
+	}
+
+	image := fmt.Sprintf("%s:%s", falcon_registry.ImageURINode(cloud), tag)
+	if digest == "" {
+		// Installed before digests were recorded in the status
+		apiConfig, err := cc.nodesensor.Spec.FalconAPI.ApiConfig(ctx, cc.client)
+		if err != nil {
+			return err
+		}
+		falconRegistry, err := falcon_registry.NewFalconRegistry(ctx, apiConfig)
+		if err != nil {
+			return err
+		}
+		sys, err := falconRegistry.SystemContext()
+		if err != nil {
+			return err
+		}
+		digest, err = registry.ImageDigest(ctx, sys, image)
+		if err != nil {
+			return err
+		}
+	}
+
+	pinned, err := registry.PinImage(image, digest)
+	if err != nil {
+		return err
+	}
+
+	cc.imageUri, cc.imageTag, cc.imageDigest = pinned, tag, digest
+	return nil
+}
+
+// decideUpdate reports whether the candidate tag is rolled out now. A candidate that is held back is recorded as pending in
+// the status along with the time it was first seen, which starts the soak period.
+func decideUpdate(policy *falconv1beta1.FalconNodeUpdatePolicy, status *falconv1beta1.FalconNodeSensorStatus, versionRequested *string, candidate string, now time.Time) (bool, time.Duration, error) {
+	checkInterval := defaultCheckInterval
+	if policy.CheckInterval != nil && policy.CheckInterval.Duration > 0 {
+		checkInterval = policy.CheckInterval.Duration
+	}
+	requeueAfter := checkInterval
+	if policy.Mode == falconv1beta1.UpdatePolicyPinned {
+		requeueAfter = 0
+	}
+
+	// Nothing is installed yet, or the installed version no longer matches the requested version
+	if status.Sensor == nil || *status.Sensor == "" || !versionMatches(versionRequested, *status.Sensor) {
+		clearPending(status)
+		return true, requeueAfter, nil
+	}
+
+	// A pinned sensor keeps the installed image, even when its tag was pushed again
+	if policy.Mode == falconv1beta1.UpdatePolicyPinned {
+		clearPending(status)
+		return false, requeueAfter, nil
+	}
+
+	if candidate == *status.Sensor {
+		clearPending(status)
+		return true, requeueAfter, nil
+	}
+
+	if status.PendingSensor != candidate || status.PendingSince == nil {
+		status.PendingSensor = candidate
+		status.PendingSince = &metav1.Time{Time: now}
+	}
+
+	// Only releases newer than the installed one have to soak; going back to an older release only waits for the window
+	if policy.SoakPeriod != nil && isNewer(candidate, *status.Sensor) {
+		soakedAt := status.PendingSince.Add(policy.SoakPeriod.Duration)
+		if now.Before(soakedAt) {
+			return false, minDuration(requeueAfter, soakedAt.Sub(now)), nil
+		}
+	}
+
+	if policy.MaintenanceWindow != nil {
+		schedule, err := cron.Parse(policy.MaintenanceWindow.Schedule)
+		if err != nil {
+			return false, 0, fmt.Errorf("Invalid maintenance window: %v", err)
+		}
+		window := cron.Window{Schedule: schedule, Duration: defaultMaintenanceWindow}
+		if policy.MaintenanceWindow.Duration != nil {
+			window.Duration = policy.MaintenanceWindow.Duration.Duration
+		}
+
+		if !window.Open(now.UTC()) {
+			if next := window.NextOpen(now.UTC()); !next.IsZero() {
+				requeueAfter = minDuration(requeueAfter, next.Sub(now))
+			}
+			return false, requeueAfter, nil
+		}
+	}
+
+	clearPending(status)
+	return true, requeueAfter, nil
+}
+
+func clearPending(status *falconv1beta1.FalconNodeSensorStatus) {
+	status.PendingSensor = ""
+	status.PendingSince = nil
+}
+
+func versionMatches(versionRequested *string, tag string) bool {
+	if versionRequested == nil {
+		return true
+	}
+	constraint, err := falcon_registry.ParseVersionConstraint(*versionRequested)
+	if err != nil {
+		return false
+	}
+	version, err := falcon_registry.ParseSensorVersion(tag)
+	if err != nil {
+		return false
+	}
+	return constraint.Matches(version)
+}
+
+func isNewer(tag, installed string) bool {
+	version, err := falcon_registry.ParseSensorVersion(tag)
+	if err != nil {
+		return true
+	}
+	installedVersion, err := falcon_registry.ParseSensorVersion(installed)
+	if err != nil {
+		return true
+	}
+	return version.Compare(installedVersion) > 0
+}
+
+// requestedVersion combines the requested version with the release line the update policy follows
+func requestedVersion(nodesensor *falconv1beta1.FalconNodeSensor) *string {
+	policy := nodesensor.Spec.Node.UpdatePolicy
+	if policy == nil {
+		return nodesensor.Spec.Node.Version
+	}
+
+	releaseLine := ""
+	switch policy.Mode {
+	case falconv1beta1.UpdatePolicyNMinus1:
+		releaseLine = "N-1"
+	case falconv1beta1.UpdatePolicyNMinus2:
+		releaseLine = "N-2"
+	default:
+		return nodesensor.Spec.Node.Version
+	}
+
+	if nodesensor.Spec.Node.Version != nil && *nodesensor.Spec.Node.Version != "" {
+		releaseLine = fmt.Sprintf("%s %s", *nodesensor.Spec.Node.Version, releaseLine)
+	}
+	return &releaseLine
+}
+
+func minDuration(a, b time.Duration) time.Duration {
+	if a > 0 && a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,148 @@
+package node
+
+import (
+	"testing"
+	"time"
+
+	falconv1beta1 "github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	installedTag = "6.45.0-14203.falcon-linux.x86_64.Release.US-1"
+	candidateTag = "6.46.0-14306.falcon-linux.x86_64.Release.US-1"
+	olderTag     = "6.44.0-14108.falcon-linux.x86_64.Release.US-1"
+)
+
+func TestDecideUpdate(t *testing.T) {
+	// Tuesday
+	now := time.Date(2022, 11, 8, 12, 0, 0, 0, time.UTC)
+	since := metav1.NewTime(now.Add(-48 * time.Hour))
+	soak := &metav1.Duration{Duration: 72 * time.Hour}
+	saturdayWindow := &falconv1beta1.FalconMaintenanceWindow{Schedule: "0 2 * * 6", Duration: &metav1.Duration{Duration: 4 * time.Hour}}
+	tuesdayWindow := &falconv1beta1.FalconMaintenanceWindow{Schedule: "0 10 * * 2", Duration: &metav1.Duration{Duration: 4 * time.Hour}}
+	version := "~6.46"
+
+	tests := []struct {
+		name             string
+		policy           falconv1beta1.FalconNodeUpdatePolicy
+		installed        string
+		pendingSince     *metav1.Time
+		versionRequested *string
+		candidate        string
+		wantAdopt        bool
+		wantRequeue      time.Duration
+		wantPending      string
+	}{
+		{"first install", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest, SoakPeriod: soak}, "", nil, nil, candidateTag, true, time.Hour, ""},
+		{"pinned keeps installed version", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyPinned}, installedTag, nil, nil, candidateTag, false, 0, ""},
+		{"pinned follows changed version request", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyPinned}, olderTag, nil, &version, candidateTag, true, 0, ""},
+		{"latest without restrictions", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest}, installedTag, nil, nil, candidateTag, true, time.Hour, ""},
+		{"same version", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest, SoakPeriod: soak}, installedTag, nil, nil, installedTag, true, time.Hour, ""},
+		{"new release starts soaking", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyNMinus1, SoakPeriod: soak}, installedTag, nil, nil, candidateTag, false, time.Hour, candidateTag},
+		{"soak period nearly over", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest, SoakPeriod: &metav1.Duration{Duration: 48*time.Hour + 10*time.Minute}}, installedTag, &since, nil, candidateTag, false, 10 * time.Minute, candidateTag},
+		{"soak period over", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest, SoakPeriod: &metav1.Duration{Duration: 24 * time.Hour}}, installedTag, &since, nil, candidateTag, true, time.Hour, ""},
+		{"maintenance window closed", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest, MaintenanceWindow: saturdayWindow, CheckInterval: &metav1.Duration{Duration: 24 * 7 * time.Hour}}, installedTag, &since, nil, candidateTag, false, 86 * time.Hour, candidateTag},
+		{"maintenance window open", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest, MaintenanceWindow: tuesdayWindow}, installedTag, &since, nil, candidateTag, true, time.Hour, ""},
+		{"older release skips soak period", falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyNMinus1, SoakPeriod: soak, CheckInterval: &metav1.Duration{Duration: 30 * time.Minute}}, installedTag, nil, nil, olderTag, true, 30 * time.Minute, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status := &falconv1beta1.FalconNodeSensorStatus{}
+			if tt.installed != "" {
+				status.Sensor = &tt.installed
+			}
+			if tt.pendingSince != nil {
+				status.PendingSensor = tt.candidate
+				status.PendingSince = tt.pendingSince
+			}
+
+			adopt, requeueAfter, err := decideUpdate(&tt.policy, status, tt.versionRequested, tt.candidate, now)
+			if err != nil {
+				t.Fatalf("decideUpdate() error = %v", err)
+			}
+			if adopt != tt.wantAdopt {
+				t.Errorf("decideUpdate() adopt = %v, want %v", adopt, tt.wantAdopt)
+			}
+			if requeueAfter != tt.wantRequeue {
+				t.Errorf("decideUpdate() requeueAfter = %v, want %v", requeueAfter, tt.wantRequeue)
+			}
+			if status.PendingSensor != tt.wantPending {
+				t.Errorf("decideUpdate() pending = %s, want %s", status.PendingSensor, tt.wantPending)
+			}
+			if tt.wantPending != "" && tt.pendingSince == nil && !status.PendingSince.Time.Equal(now) {
+				t.Errorf("decideUpdate() pendingSince = %v, want %v", status.PendingSince, now)
+			}
+			if tt.wantPending == "" && status.PendingSince != nil {
+				t.Errorf("decideUpdate() pendingSince = %v, want nil", status.PendingSince)
+			}
+		})
+	}
+}
+
+func TestDecideUpdateInvalidWindow(t *testing.T) {
+	installed := installedTag
+	policy := &falconv1beta1.FalconNodeUpdatePolicy{
+		Mode:              falconv1beta1.UpdatePolicyLatest,
+		MaintenanceWindow: &falconv1beta1.FalconMaintenanceWindow{Schedule: "every saturday"},
+	}
+	status := &falconv1beta1.FalconNodeSensorStatus{Sensor: &installed}
+
+	if _, _, err := decideUpdate(policy, status, nil, candidateTag, time.Now()); err == nil {
+		t.Errorf("decideUpdate() expected an error for an invalid maintenance window")
+	}
+}
+
+func TestRequestedVersion(t *testing.T) {
+	version := ">=6.40 <7"
+
+	tests := []struct {
+		name    string
+		version *string
+		policy  *falconv1beta1.FalconNodeUpdatePolicy
+		want    string
+	}{
+		{"no policy", &version, nil, version},
+		{"latest", &version, &falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyLatest}, version},
+		{"n-1", nil, &falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyNMinus1}, "N-1"},
+		{"n-2 within version", &version, &falconv1beta1.FalconNodeUpdatePolicy{Mode: falconv1beta1.UpdatePolicyNMinus2}, ">=6.40 <7 N-2"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			nodesensor := &falconv1beta1.FalconNodeSensor{}
+			nodesensor.Spec.Node.Version = tt.version
+			nodesensor.Spec.Node.UpdatePolicy = tt.policy
+
+			got := requestedVersion(nodesensor)
+			if got == nil || *got != tt.want {
+				t.Errorf("requestedVersion() = %v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVersionMatches(t *testing.T) {
+	tag := "6.45.0-14203.falcon-linux.x86_64.Release.US-1"
+
+	tests := []struct {
+		version string
+		want    bool
+	}{
+		{"6.45", true},
+		{"6.45.0-14203", true},
+		{tag, true},
+		{"6.45.0-14210.falcon-linux.x86_64.Release.US-1", false},
+		{"~6.46", false},
+		{"newest", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.version, func(t *testing.T) {
+			if got := versionMatches(&tt.version, tag); got != tt.want {
+				t.Errorf("versionMatches(%s, %s) = %v, want %v", tt.version, tag, got, tt.want)
+			}
+		})
+	}
+}
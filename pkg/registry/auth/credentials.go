@@ -61,6 +61,23 @@ func GetPushCredentials(secrets []corev1.Secret) Credentials {
 	return nil
 }
 
+// SecretCredentials returns the credentials held by a Secret of type kubernetes.io/dockerconfigjson or kubernetes.io/dockercfg
+func SecretCredentials(secret corev1.Secret) (Credentials, error) {
+	switch secret.Type {
+	case corev1.SecretTypeDockerConfigJson:
+		if value, ok := secret.Data[corev1.DockerConfigJsonKey]; ok && dockerJsonValid(value) {
+			return &classic{name: secret.Name, value: value}, nil
+		}
+	case corev1.SecretTypeDockercfg:
+		if value, ok := secret.Data[corev1.DockerConfigKey]; ok {
+			return &legacy{name: secret.Name, Dockercfg: value}, nil
+		}
+	default:
+		return nil, fmt.Errorf("Secret %s/%s is of type %s, expected %s", secret.Namespace, secret.Name, secret.Type, corev1.SecretTypeDockerConfigJson)
+	}
+	return nil, fmt.Errorf("Secret %s/%s does not contain valid registry credentials", secret.Namespace, secret.Name)
+}
+
 // Legacy represents old .dockercfg based credentials
 type legacy struct {
 	name      string
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestSecretCredentials(t *testing.T) {
+	dockerConfig, err := Dockerfile("harbor.example.com", "robot$falcon", "secret")
+	if err != nil {
+		t.Fatalf("Dockerfile() error = %v", err)
+	}
+
+	tests := []struct {
+		name    string
+		secret  corev1.Secret
+		want    []byte
+		wantErr bool
+	}{
+		{"dockerconfigjson", corev1.Secret{Type: corev1.SecretTypeDockerConfigJson, Data: map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig}}, dockerConfig, false},
+		{"dockercfg", corev1.Secret{Type: corev1.SecretTypeDockercfg, Data: map[string][]byte{corev1.DockerConfigKey: []byte("{}")}}, []byte("{}"), false},
+		{"no auths", corev1.Secret{Type: corev1.SecretTypeDockerConfigJson, Data: map[string][]byte{corev1.DockerConfigJsonKey: []byte(`{"auths":{}}`)}}, nil, true},
+		{"missing key", corev1.Secret{Type: corev1.SecretTypeDockerConfigJson, Data: map[string][]byte{"config.json": dockerConfig}}, nil, true},
+		{"opaque", corev1.Secret{Type: corev1.SecretTypeOpaque, Data: map[string][]byte{corev1.DockerConfigJsonKey: dockerConfig}}, nil, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.secret.Name = "registry-push"
+			creds, err := SecretCredentials(tt.secret)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("SecretCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if creds.Name() != "registry-push" {
+				t.Errorf("SecretCredentials() name = %s, want registry-push", creds.Name())
+			}
+			got, err := creds.Pulltoken()
+			if err != nil {
+				t.Fatalf("Pulltoken() error = %v", err)
+			}
+			if string(got) != string(tt.want) {
+				t.Errorf("Pulltoken() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/types"
+)
+
+// ImageDigest resolves the manifest digest the image reference currently points to
+func ImageDigest(ctx context.Context, sys *types.SystemContext, image string) (string, error) {
+	ref, err := docker.ParseReference("//" + image)
+	if err != nil {
+		return "", fmt.Errorf("Invalid image reference %s: %v", image, err)
+	}
+
+	digest, err := docker.GetDigest(ctx, sys, ref)
+	if err != nil {
+		return "", fmt.Errorf("Cannot resolve manifest digest of image %s: %v", image, err)
+	}
+	return digest.String(), nil
+}
+
+// PinImage replaces the tag of the image reference with the manifest digest, so that the reference keeps
+// pointing to the same image when the tag is pushed again
+func PinImage(image, digest string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", fmt.Errorf("Invalid image reference %s: %v", image, err)
+	}
+
+	pinned, err := reference.ParseNormalizedNamed(fmt.Sprintf("%s@%s", reference.TrimNamed(named).String(), digest))
+	if err != nil {
+		return "", fmt.Errorf("Cannot pin image %s to digest %s: %v", image, digest, err)
+	}
+	return pinned.String(), nil
+}
+
+// SplitImage returns the tag and the manifest digest of the image reference; either is empty when not present
+func SplitImage(image string) (tag string, digest string) {
+	if i := strings.LastIndex(image, "@"); i >= 0 {
+		digest = image[i+1:]
+		image = image[:i]
+	}
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		tag = image[i+1:]
+	}
+	return tag, digest
+}
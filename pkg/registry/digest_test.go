@@ -0,0 +1,58 @@
+package registry
+
+import (
+	"testing"
+)
+
+const testDigest = "sha256:8d6a8f0a5bc7b1b9c2d32e3e1d5c3a3e4b0b9bdb0d6e61f0ea3ee1f2b9f8d2c1"
+
+func TestPinImage(t *testing.T) {
+	tests := []struct {
+		image   string
+		want    string
+		wantErr bool
+	}{
+		{"registry.crowdstrike.com/falcon-sensor/us-1/release/falcon-sensor:6.45.0-14203.falcon-linux.x86_64.Release.US-1", "registry.crowdstrike.com/falcon-sensor/us-1/release/falcon-sensor@" + testDigest, false},
+		{"myregistry.example.com:5000/falcon-container:6.45.0-2201.container.x86_64.Release.US-1", "myregistry.example.com:5000/falcon-container@" + testDigest, false},
+		{"myregistry.example.com/falcon-container", "myregistry.example.com/falcon-container@" + testDigest, false},
+		{"Not A Valid Image", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			got, err := PinImage(tt.image, testDigest)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("PinImage() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("PinImage() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := PinImage("myregistry.example.com/falcon-container:latest", "sha256:tooshort"); err == nil {
+		t.Errorf("PinImage() expected an error for an invalid digest")
+	}
+}
+
+func TestSplitImage(t *testing.T) {
+	tests := []struct {
+		image      string
+		wantTag    string
+		wantDigest string
+	}{
+		{"registry.crowdstrike.com/falcon-sensor/us-1/release/falcon-sensor:6.45.0-14203", "6.45.0-14203", ""},
+		{"registry.crowdstrike.com/falcon-sensor/us-1/release/falcon-sensor@" + testDigest, "", testDigest},
+		{"myregistry.example.com:5000/falcon-container:6.45.0@" + testDigest, "6.45.0", testDigest},
+		{"myregistry.example.com:5000/falcon-container", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.image, func(t *testing.T) {
+			tag, digest := SplitImage(tt.image)
+			if tag != tt.wantTag || digest != tt.wantDigest {
+				t.Errorf("SplitImage() = (%s, %s), want (%s, %s)", tag, digest, tt.wantTag, tt.wantDigest)
+			}
+		})
+	}
+}
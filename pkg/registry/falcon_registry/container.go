@@ -14,10 +14,8 @@ func (reg *FalconRegistry) LastContainerTag(ctx context.Context, versionRequeste
 		return "", err
 	}
 
-	return lastTag(ctx, systemContext, reg.imageUriContainer(), func(tag string) bool {
-		return (tag[0] >= '0' && tag[0] <= '9' &&
-			strings.Contains(tag, ".container.x86_64") &&
-			(versionRequested == nil || strings.HasPrefix(tag, *versionRequested)))
+	return lastTag(ctx, systemContext, reg.imageUriContainer(), versionRequested, func(tag string) bool {
+		return strings.Contains(tag, ".container.x86_64")
 	})
 }
 
@@ -8,16 +8,30 @@ import (
 	"github.com/crowdstrike/gofalcon/falcon"
 )
 
-func (reg *FalconRegistry) LastNodeTag(ctx context.Context, versionRequested *string) (string, error) {
+// nodeTagArchitectures maps the kubernetes.io/arch node label to the architecture in the node sensor image tags
+var nodeTagArchitectures = map[string]string{
+	"amd64": "x86_64",
+	"arm64": "aarch64",
+}
+
+// NodeTagMatchesArchitecture reports whether the node sensor image tag is built for the given node architecture
+func NodeTagMatchesArchitecture(tag, arch string) bool {
+	tagArch, ok := nodeTagArchitectures[arch]
+	return ok && strings.Contains(tag, ".falcon-linux."+tagArch)
+}
+
+func (reg *FalconRegistry) LastNodeTag(ctx context.Context, arch string, versionRequested *string) (string, error) {
+	if _, ok := nodeTagArchitectures[arch]; !ok {
+		return "", fmt.Errorf("Unsupported node architecture %s", arch)
+	}
+
 	systemContext, err := reg.systemContext()
 	if err != nil {
 		return "", err
 	}
 
-	return lastTag(ctx, systemContext, reg.imageUriNode(), func(tag string) bool {
-		return (tag[0] >= '0' && tag[0] <= '9' &&
-			strings.Contains(tag, ".falcon-linux.x86_64") &&
-			(versionRequested == nil || strings.HasPrefix(tag, *versionRequested)))
+	return lastTag(ctx, systemContext, reg.imageUriNode(), versionRequested, func(tag string) bool {
+		return NodeTagMatchesArchitecture(tag, arch)
 	})
 }
 
@@ -83,7 +83,7 @@ func imageReference(imageUri, tag string) (types.ImageReference, error) {
 	return docker.ParseReference(fmt.Sprintf("//%s:%s", imageUri, tag))
 }
 
-func lastTag(ctx context.Context, systemContext *types.SystemContext, imageUri string, filter func(string) bool) (string, error) {
+func lastTag(ctx context.Context, systemContext *types.SystemContext, imageUri string, versionRequested *string, filter func(string) bool) (string, error) {
 	ref, err := reference.ParseNormalizedNamed(imageUri)
 	if err != nil {
 		return "", err
@@ -97,21 +97,32 @@ func lastTag(ctx context.Context, systemContext *types.SystemContext, imageUri s
 	if err != nil {
 		return "", err
 	}
-	return guessLastTag(tags, filter)
+	return guessLastTag(tags, versionRequested, filter)
 }
 
-func guessLastTag(tags []string, filter func(string) bool) (string, error) {
+// guessLastTag returns the tag with the highest sensor version that passes the filter and satisfies the requested version constraint
+func guessLastTag(tags []string, versionRequested *string, filter func(string) bool) (string, error) {
+	expression := ""
+	if versionRequested != nil {
+		expression = *versionRequested
+	}
+	constraint, err := ParseVersionConstraint(expression)
+	if err != nil {
+		return "", err
+	}
+
 	filteredTags := []string{}
 	for _, tag := range tags {
 		if filter(tag) {
 			filteredTags = append(filteredTags, tag)
 		}
 	}
-	if len(filteredTags) == 0 {
-		return "", fmt.Errorf("Could not find suitable image tag in the CrowdStrike registry. Tags were: %+v", tags)
-	}
 
-	return filteredTags[len(filteredTags)-1], nil
+	tag, ok := constraint.Select(filteredTags)
+	if !ok {
+		return "", fmt.Errorf("Could not find suitable image tag in the CrowdStrike registry for version %q. Tags were: %+v", expression, tags)
+	}
+	return tag, nil
 }
 
 func listDockerTags(ctx context.Context, sys *types.SystemContext, imgRef types.ImageReference) ([]string, error) {
@@ -122,6 +133,11 @@ func listDockerTags(ctx context.Context, sys *types.SystemContext, imgRef types.
 	return tags, nil
 }
 
+// SystemContext returns the containers/image system context authenticated to the CrowdStrike registry
+func (fr *FalconRegistry) SystemContext() (*types.SystemContext, error) {
+	return fr.systemContext()
+}
+
 func (fr *FalconRegistry) systemContext() (*types.SystemContext, error) {
 	username, err := fr.username()
 	if err != nil {
@@ -0,0 +1,256 @@
+package falcon_registry
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// sensorVersionRegexp matches the version at the start of a Falcon sensor tag, for instance
+// 6.45.0-14203 in 6.45.0-14203.falcon-linux.x86_64.Release.US-1
+var sensorVersionRegexp = regexp.MustCompile(`^(\d+)\.(\d+)\.(\d+)(?:-(\d+))?`)
+
+// SensorVersion is the comparable version of a Falcon sensor image tag
+type SensorVersion struct {
+	Major int
+	Minor int
+	Patch int
+	Build int
+}
+
+// ParseSensorVersion parses the version at the start of a Falcon sensor image tag
+func ParseSensorVersion(tag string) (SensorVersion, error) {
+	m := sensorVersionRegexp.FindStringSubmatch(tag)
+	if m == nil {
+		return SensorVersion{}, fmt.Errorf("Cannot parse Falcon sensor version from tag %s", tag)
+	}
+
+	v := SensorVersion{}
+	v.Major, _ = strconv.Atoi(m[1])
+	v.Minor, _ = strconv.Atoi(m[2])
+	v.Patch, _ = strconv.Atoi(m[3])
+	if m[4] != "" {
+		v.Build, _ = strconv.Atoi(m[4])
+	}
+	return v, nil
+}
+
+// Compare returns -1, 0 or 1 when the version is lower than, equal to or higher than the other version
+func (v SensorVersion) Compare(other SensorVersion) int {
+	for _, d := range [...]int{v.Major - other.Major, v.Minor - other.Minor, v.Patch - other.Patch, v.Build - other.Build} {
+		switch {
+		case d < 0:
+			return -1
+		case d > 0:
+			return 1
+		}
+	}
+	return 0
+}
+
+func (v SensorVersion) String() string {
+	return fmt.Sprintf("%d.%d.%d-%d", v.Major, v.Minor, v.Patch, v.Build)
+}
+
+// VersionConstraint selects Falcon sensor versions. It is parsed from an expression of one or more
+// alternatives separated by "||", each a space separated list of terms that must all hold:
+//
+//	6.45            versions starting with 6.45 (6.45.x, but not 6.450)
+//	6.45.0-14203    exactly that version; a full tag such as 6.45.0-14203.falcon-linux.x86_64.Release.US-1 is read the same way
+//	~6.45           at least 6.45 and below 6.46
+//	^6.45           at least 6.45 and below 7
+//	>=6.40 <7       comparisons with =, <, <=, > and >=; missing components are zero
+//	N-1             the newest version of the release line (major.minor) before the newest matching line
+type VersionConstraint struct {
+	alternatives [][]versionTerm
+	// linesBack is the number of release lines to step back from the newest matching line
+	linesBack int
+}
+
+type versionTerm struct {
+	op      string
+	version SensorVersion
+	// components is the number of version components given in the expression
+	components int
+}
+
+var releaseLineRegexp = regexp.MustCompile(`^[nN](?:-(\d+))?$`)
+
+// ParseVersionConstraint parses a version constraint expression. An empty expression matches any version.
+func ParseVersionConstraint(expression string) (*VersionConstraint, error) {
+	c := &VersionConstraint{}
+	for _, alternative := range strings.Split(expression, "||") {
+		terms := []versionTerm{}
+		fields := strings.Fields(alternative)
+		for i := 0; i < len(fields); i++ {
+			field := fields[i]
+
+			if m := releaseLineRegexp.FindStringSubmatch(field); m != nil {
+				if m[1] != "" {
+					c.linesBack, _ = strconv.Atoi(m[1])
+				}
+				// "N-1 latest" reads naturally, so the trailing word is allowed
+				if i+1 < len(fields) && strings.EqualFold(fields[i+1], "latest") {
+					i++
+				}
+				continue
+			}
+
+			// Allow a space between the operator and the version, as in ">= 6.40"
+			if strings.Trim(field, "<>=~^") == "" && i+1 < len(fields) {
+				i++
+				field += fields[i]
+			}
+
+			term, err := parseVersionTerm(field)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid version constraint %q: %v", expression, err)
+			}
+			terms = append(terms, term)
+		}
+		if len(terms) > 0 {
+			c.alternatives = append(c.alternatives, terms)
+		}
+	}
+	return c, nil
+}
+
+func parseVersionTerm(field string) (versionTerm, error) {
+	term := versionTerm{}
+	for _, op := range []string{">=", "<=", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(field, op) {
+			term.op = op
+			field = strings.TrimPrefix(field, op)
+			break
+		}
+	}
+
+	version := field
+	build := ""
+	if i := strings.Index(field, "-"); i >= 0 {
+		version, build = field[:i], field[i+1:]
+		// full sensor tags carry the image flavour after the build number
+		if j := strings.Index(build, "."); j >= 0 {
+			build = build[:j]
+		}
+	}
+
+	components := [4]int{}
+	parts := strings.Split(version, ".")
+	if len(parts) > 3 || (build != "" && len(parts) != 3) {
+		return term, fmt.Errorf("unexpected version %s", field)
+	}
+	if build != "" {
+		parts = append(parts, build)
+	}
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return term, fmt.Errorf("unexpected version %s", field)
+		}
+		components[i] = n
+	}
+
+	term.version = SensorVersion{Major: components[0], Minor: components[1], Patch: components[2], Build: components[3]}
+	term.components = len(parts)
+	return term, nil
+}
+
+// Matches reports whether the version satisfies the constraint, ignoring any N-x release line selection
+func (c *VersionConstraint) Matches(v SensorVersion) bool {
+	if len(c.alternatives) == 0 {
+		return true
+	}
+	for _, terms := range c.alternatives {
+		matches := true
+		for _, term := range terms {
+			if !term.matches(v) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func (t versionTerm) matches(v SensorVersion) bool {
+	lower := t.version
+	switch t.op {
+	case "", "=":
+		return t.prefixOf(v)
+	case ">=":
+		return v.Compare(lower) >= 0
+	case "<":
+		return v.Compare(lower) < 0
+	case ">":
+		// >6.45 excludes every 6.45.x version
+		return v.Compare(lower) > 0 && !t.prefixOf(v)
+	case "<=":
+		return v.Compare(lower) <= 0 || t.prefixOf(v)
+	case "~":
+		upper := SensorVersion{Major: lower.Major + 1}
+		if t.components > 1 {
+			upper = SensorVersion{Major: lower.Major, Minor: lower.Minor + 1}
+		}
+		return v.Compare(lower) >= 0 && v.Compare(upper) < 0
+	case "^":
+		return v.Compare(lower) >= 0 && v.Compare(SensorVersion{Major: lower.Major + 1}) < 0
+	}
+	return false
+}
+
+// prefixOf reports whether the version components given in the expression are equal to those of the version
+func (t versionTerm) prefixOf(v SensorVersion) bool {
+	want := [...]int{t.version.Major, t.version.Minor, t.version.Patch, t.version.Build}
+	got := [...]int{v.Major, v.Minor, v.Patch, v.Build}
+	for i := 0; i < t.components; i++ {
+		if want[i] != got[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Select returns the tag with the highest version that satisfies the constraint, or false when there is none.
+// Tags that do not start with a version are skipped.
+func (c *VersionConstraint) Select(tags []string) (string, bool) {
+	type candidate struct {
+		tag     string
+		version SensorVersion
+	}
+
+	// Collected in reverse, so that the last tag the registry lists wins between tags of the same version
+	candidates := []candidate{}
+	for i := len(tags) - 1; i >= 0; i-- {
+		tag := tags[i]
+		v, err := ParseSensorVersion(tag)
+		if err != nil || !c.Matches(v) {
+			continue
+		}
+		candidates = append(candidates, candidate{tag, v})
+	}
+	if len(candidates) == 0 {
+		return "", false
+	}
+
+	// Newest first
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].version.Compare(candidates[j].version) > 0
+	})
+
+	lines := 0
+	for i, candidate := range candidates {
+		if i > 0 && (candidate.version.Major != candidates[i-1].version.Major || candidate.version.Minor != candidates[i-1].version.Minor) {
+			lines++
+		}
+		if lines == c.linesBack {
+			return candidate.tag, true
+		}
+	}
+	return "", false
+}
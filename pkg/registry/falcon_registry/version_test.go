@@ -0,0 +1,174 @@
+package falcon_registry
+
+import (
+	"strings"
+	"testing"
+)
+
+// nodeTags is a tag list in the order the CrowdStrike registry returns it for the node sensor repository
+var nodeTags = []string{
+	"6.10.0-12904.falcon-linux.x86_64.Release.US-1",
+	"6.1.0-11503.falcon-linux.x86_64.Release.US-1",
+	"6.38.0-13501.falcon-linux.x86_64.Release.US-1",
+	"6.39.0-13601.falcon-linux.x86_64.Release.US-1",
+	"6.40.0-13707.falcon-linux.x86_64.Release.US-1",
+	"6.41.0-13804.falcon-linux.x86_64.Release.US-1",
+	"6.43.0-14005.falcon-linux.x86_64.Release.US-1",
+	"6.44.0-14108.falcon-linux.x86_64.Release.US-1",
+	"6.45.0-14203.falcon-linux.x86_64.Release.US-1",
+	"6.45.0-14210.falcon-linux.x86_64.Release.US-1",
+	"6.46.0-14306.falcon-linux.x86_64.Release.US-1",
+	"6.47.0-14408.falcon-linux.x86_64.Release.US-1",
+	"6.47.0-14408.falcon-linux.aarch64.Release.US-1",
+	"6.5.0-11901.falcon-linux.x86_64.Release.US-1",
+	"7.01.0-15604.falcon-linux.x86_64.Release.US-1",
+	"latest",
+}
+
+// containerTags is a tag list in the order the CrowdStrike registry returns it for the Falcon Container repository
+var containerTags = []string{
+	"6.31.0-1602.container.x86_64.Release.US-1",
+	"6.40.0-2004.container.x86_64.Release.US-1",
+	"6.45.0-2201.container.x86_64.Release.US-1",
+	"6.47.0-3003.container.x86_64.Release.US-1",
+	"6.9.0-1211.container.x86_64.Release.US-1",
+	"sha256-9a4c8d6a6b0e4f2b5b2f04fdbd9a9c1e7a9b2c3d4e5f60718293a4b5c6d7e8f9.sig",
+}
+
+func TestParseSensorVersion(t *testing.T) {
+	tests := []struct {
+		tag     string
+		want    SensorVersion
+		wantErr bool
+	}{
+		{"6.45.0-14203.falcon-linux.x86_64.Release.US-1", SensorVersion{6, 45, 0, 14203}, false},
+		{"6.47.0-3003.container.x86_64.Release.US-1", SensorVersion{6, 47, 0, 3003}, false},
+		{"7.01.0-15604.falcon-linux.x86_64.Release.US-1", SensorVersion{7, 1, 0, 15604}, false},
+		{"6.45.1", SensorVersion{6, 45, 1, 0}, false},
+		{"latest", SensorVersion{}, true},
+		{"sha256-9a4c8d6a.sig", SensorVersion{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got, err := ParseSensorVersion(tt.tag)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseSensorVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ParseSensorVersion() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSensorVersionCompare(t *testing.T) {
+	tests := []struct {
+		a, b SensorVersion
+		want int
+	}{
+		{SensorVersion{6, 10, 0, 0}, SensorVersion{6, 9, 0, 0}, 1},
+		{SensorVersion{6, 45, 0, 14203}, SensorVersion{6, 45, 0, 14210}, -1},
+		{SensorVersion{6, 45, 0, 14203}, SensorVersion{6, 45, 0, 14203}, 0},
+		{SensorVersion{7, 0, 0, 0}, SensorVersion{6, 99, 9, 99999}, 1},
+	}
+
+	for _, tt := range tests {
+		if got := tt.a.Compare(tt.b); got != tt.want {
+			t.Errorf("%v.Compare(%v) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestVersionConstraintFullTag(t *testing.T) {
+	c, err := ParseVersionConstraint("6.45.0-14203.falcon-linux.x86_64.Release.US-1")
+	if err != nil {
+		t.Fatalf("ParseVersionConstraint() error = %v", err)
+	}
+	tests := []struct {
+		v    SensorVersion
+		want bool
+	}{
+		{SensorVersion{6, 45, 0, 14203}, true},
+		{SensorVersion{6, 45, 0, 14210}, false},
+		{SensorVersion{6, 45, 1, 14203}, false},
+	}
+
+	for _, tt := range tests {
+		if got := c.Matches(tt.v); got != tt.want {
+			t.Errorf("Matches(%v) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestParseVersionConstraintInvalid(t *testing.T) {
+	for _, expression := range []string{"six", ">=6.x", "6.45.0.1", "6.45-14203", "6.45.0-x.falcon-linux", "~"} {
+		if _, err := ParseVersionConstraint(expression); err == nil {
+			t.Errorf("ParseVersionConstraint(%q) expected an error", expression)
+		}
+	}
+}
+
+func TestGuessLastTag(t *testing.T) {
+	nodeFilter := func(tag string) bool { return NodeTagMatchesArchitecture(tag, "amd64") }
+	arm64Filter := func(tag string) bool { return NodeTagMatchesArchitecture(tag, "arm64") }
+	containerFilter := func(tag string) bool { return strings.Contains(tag, ".container.x86_64") }
+
+	tests := []struct {
+		name    string
+		tags    []string
+		filter  func(string) bool
+		version string
+		want    string
+		wantErr bool
+	}{
+		{"node newest", nodeTags, nodeFilter, "", "7.01.0-15604.falcon-linux.x86_64.Release.US-1", false},
+		{"node prefix does not match longer minor", nodeTags, nodeFilter, "6.1", "6.1.0-11503.falcon-linux.x86_64.Release.US-1", false},
+		{"node exact", nodeTags, nodeFilter, "6.45.0-14203", "6.45.0-14203.falcon-linux.x86_64.Release.US-1", false},
+		{"node full tag", nodeTags, nodeFilter, "6.45.0-14203.falcon-linux.x86_64.Release.US-1", "6.45.0-14203.falcon-linux.x86_64.Release.US-1", false},
+		{"node full tag not built", nodeTags, nodeFilter, "6.45.0-14204.falcon-linux.x86_64.Release.US-1", "", true},
+		{"arm64 full tag", nodeTags, arm64Filter, "6.47.0-14408.falcon-linux.aarch64.Release.US-1", "6.47.0-14408.falcon-linux.aarch64.Release.US-1", false},
+		{"node prefix newest build", nodeTags, nodeFilter, "6.45", "6.45.0-14210.falcon-linux.x86_64.Release.US-1", false},
+		{"node tilde", nodeTags, nodeFilter, "~6.45", "6.45.0-14210.falcon-linux.x86_64.Release.US-1", false},
+		{"node tilde major", nodeTags, nodeFilter, "~6", "6.47.0-14408.falcon-linux.x86_64.Release.US-1", false},
+		{"node caret", nodeTags, nodeFilter, "^6.40", "6.47.0-14408.falcon-linux.x86_64.Release.US-1", false},
+		{"node range", nodeTags, nodeFilter, ">=6.40 <7", "6.47.0-14408.falcon-linux.x86_64.Release.US-1", false},
+		{"node range with spaces", nodeTags, nodeFilter, ">= 6.40 < 6.45", "6.44.0-14108.falcon-linux.x86_64.Release.US-1", false},
+		{"node less or equal partial", nodeTags, nodeFilter, "<=6.45", "6.45.0-14210.falcon-linux.x86_64.Release.US-1", false},
+		{"node greater partial", nodeTags, nodeFilter, ">6.44 <6.46", "6.45.0-14210.falcon-linux.x86_64.Release.US-1", false},
+		{"node alternatives", nodeTags, nodeFilter, "~6.39 || ~6.41", "6.41.0-13804.falcon-linux.x86_64.Release.US-1", false},
+		{"node N", nodeTags, nodeFilter, "N", "7.01.0-15604.falcon-linux.x86_64.Release.US-1", false},
+		{"node N-1 latest", nodeTags, nodeFilter, "N-1 latest", "6.47.0-14408.falcon-linux.x86_64.Release.US-1", false},
+		{"node N-2", nodeTags, nodeFilter, "N-2", "6.46.0-14306.falcon-linux.x86_64.Release.US-1", false},
+		{"node N-1 within range", nodeTags, nodeFilter, ">=6.40 <7 N-1", "6.46.0-14306.falcon-linux.x86_64.Release.US-1", false},
+		{"node N-1 skips builds of the same line", nodeTags, nodeFilter, "<6.46 N-1", "6.44.0-14108.falcon-linux.x86_64.Release.US-1", false},
+		{"node no match", nodeTags, nodeFilter, "~5", "", true},
+		{"node not enough lines", nodeTags, nodeFilter, "~6.45 N-1", "", true},
+		{"node invalid", nodeTags, nodeFilter, "newest", "", true},
+		{"arm64 newest", nodeTags, arm64Filter, "", "6.47.0-14408.falcon-linux.aarch64.Release.US-1", false},
+		{"arm64 same release", nodeTags, arm64Filter, "6.47.0", "6.47.0-14408.falcon-linux.aarch64.Release.US-1", false},
+		{"arm64 release not built", nodeTags, arm64Filter, "6.46.0", "", true},
+		{"container newest skips signatures", containerTags, containerFilter, "", "6.47.0-3003.container.x86_64.Release.US-1", false},
+		{"container prefix does not match longer minor", containerTags, containerFilter, "6.4", "", true},
+		{"container tilde", containerTags, containerFilter, "~6.45", "6.45.0-2201.container.x86_64.Release.US-1", false},
+		{"container N-1", containerTags, containerFilter, "N-1", "6.45.0-2201.container.x86_64.Release.US-1", false},
+		{"container numeric order", containerTags, containerFilter, "<6.31", "6.9.0-1211.container.x86_64.Release.US-1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version := &tt.version
+			if tt.version == "" {
+				version = nil
+			}
+
+			got, err := guessLastTag(tt.tags, version, tt.filter)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("guessLastTag() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("guessLastTag() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
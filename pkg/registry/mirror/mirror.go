@@ -0,0 +1,184 @@
+package mirror
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/containers/image/v5/copy"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/signature"
+	"github.com/containers/image/v5/transports/alltransports"
+	"github.com/containers/image/v5/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	k8stypes "k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+	"github.com/crowdstrike/falcon-operator/pkg/common"
+	"github.com/crowdstrike/falcon-operator/pkg/k8s_utils"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/auth"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/pushtoken"
+	"github.com/crowdstrike/falcon-operator/pkg/registry/verification"
+)
+
+// Copy copies the source image to the destination, a repository:tag reference. When image verification is enabled, the source
+// image is verified first and the exact manifest that passed verification is copied along with its signatures.
+func Copy(ctx context.Context, log logr.Logger, srcRef types.ImageReference, sourceCtx *types.SystemContext, destination string, destinationCtx *types.SystemContext, imageVerification *v1beta1.ImageVerificationSpec) error {
+	if verification.Enabled(imageVerification) {
+		// Copy the exact manifest that passed verification, so that the tag cannot move in between
+		manifestDigest, err := verification.Verify(ctx, imageVerification, srcRef, sourceCtx, "")
+		if err != nil {
+			return err
+		}
+		log.Info("Falcon image passed signature verification", "digest", manifestDigest)
+
+		srcRef, err = verification.Pin(srcRef, manifestDigest)
+		if err != nil {
+			return err
+		}
+
+		// Copy the signatures along with the image, so that the mirrored image can be verified before rollout
+		if sourceCtx, err = verification.SystemContext(sourceCtx); err != nil {
+			return err
+		}
+		if destinationCtx, err = verification.SystemContext(destinationCtx); err != nil {
+			return err
+		}
+	}
+
+	policy := &signature.Policy{Default: []signature.PolicyRequirement{signature.NewPRInsecureAcceptAnything()}}
+	policyContext, err := signature.NewPolicyContext(policy)
+	if err != nil {
+		return fmt.Errorf("Error loading trust policy: %v", err)
+	}
+	defer func() { _ = policyContext.Destroy() }()
+
+	dest := fmt.Sprintf("docker://%s", destination)
+	destRef, err := alltransports.ParseImageName(dest)
+	if err != nil {
+		return fmt.Errorf("Invalid destination name %s: %v", dest, err)
+	}
+
+	log.Info("Identified the target location for image push", "reference", destRef.DockerReference().String())
+	_, err = copy.Image(ctx, policyContext, destRef, srcRef,
+		&copy.Options{
+			ReportWriter:   os.Stdout,
+			SourceCtx:      sourceCtx,
+			DestinationCtx: destinationCtx,
+		},
+	)
+	return wrapWithHint(err)
+}
+
+// Credentials returns the credentials for pushing to the registry. The generic registry type reads them from pushSecretRef,
+// the other types look them up in the namespace, which is also the default namespace of pushSecretRef.
+func Credentials(ctx context.Context, cli client.Reader, registry *v1beta1.RegistrySpec, namespace string) (auth.Credentials, error) {
+	if registry.Type != v1beta1.RegistryTypeGeneric {
+		return pushtoken.GetCredentials(ctx, registry.Type, k8s_utils.QuerySecretsInNamespace(cli, namespace))
+	}
+
+	secretRef := registry.PushSecretRef
+	if secretRef == nil || secretRef.Name == "" {
+		return nil, fmt.Errorf("Cannot push Falcon Image to generic registry. pushSecretRef was not specified")
+	}
+	if secretRef.Namespace != "" {
+		namespace = secretRef.Namespace
+	}
+
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, k8stypes.NamespacedName{Name: secretRef.Name, Namespace: namespace}, secret); err != nil {
+		return nil, fmt.Errorf("Cannot read registry push credentials from Secret %s/%s: %v", namespace, secretRef.Name, err)
+	}
+
+	return auth.SecretCredentials(*secret)
+}
+
+// DestinationContext returns the system context for pushing to and reading from the registry with the push credentials
+func DestinationContext(creds auth.Credentials, tls v1beta1.RegistryTLSSpec, certDir string) (*types.SystemContext, error) {
+	sys, err := creds.DestinationContext()
+	if err != nil {
+		return nil, err
+	}
+
+	if tls.InsecureSkipVerify {
+		sys.DockerInsecureSkipTLSVerify = types.OptionalBoolTrue
+	}
+	if certDir != "" {
+		sys.DockerCertPath = certDir
+	}
+
+	return sys, nil
+}
+
+// GenericRepository validates the uri of the generic registry type and returns the normalized repository name
+func GenericRepository(uri string) (string, error) {
+	if uri == "" {
+		return "", fmt.Errorf("Cannot push Falcon Image to generic registry. uri was not specified")
+	}
+
+	named, err := reference.ParseNormalizedNamed(uri)
+	if err != nil || !reference.IsNameOnly(named) {
+		return "", fmt.Errorf("Invalid registry uri %s: expected a repository without tag or digest", uri)
+	}
+
+	return named.Name(), nil
+}
+
+// CertDir writes the CA certificates configured for the registry to a directory that is used as DockerCertPath, so that
+// the operator trusts the registry it pushes to. The CA ConfigMap is read from the namespace and the certificates are written
+// under dirName in the temporary directory. It returns an empty string when no CA certificates are configured.
+func CertDir(ctx context.Context, cli client.Reader, tls v1beta1.RegistryTLSSpec, namespace, dirName string) (string, error) {
+	certs := map[string]string{}
+
+	switch {
+	case tls.CACertificate != "":
+		certs["ca.crt"] = string(common.DecodeBase64Interface(tls.CACertificate))
+	case tls.CACertificateConfigMap != "":
+		configMap := &corev1.ConfigMap{}
+		err := cli.Get(ctx, k8stypes.NamespacedName{Name: tls.CACertificateConfigMap, Namespace: namespace}, configMap)
+		if err != nil {
+			return "", fmt.Errorf("Cannot read registry CA certificates from ConfigMap %s: %v", tls.CACertificateConfigMap, err)
+		}
+		for key, value := range configMap.Data {
+			if strings.HasSuffix(key, ".crt") {
+				certs[key] = value
+			}
+		}
+	}
+
+	if len(certs) == 0 {
+		return "", nil
+	}
+
+	dir := filepath.Join(os.TempDir(), "falcon-registry-certs", dirName)
+	if err := os.RemoveAll(dir); err != nil {
+		return "", err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+	for name, cert := range certs {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(cert), 0600); err != nil {
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+func wrapWithHint(in error) error {
+	// Use of credentials store outside of docker command is somewhat limited
+	// See https://github.com/moby/moby/issues/39377
+	// https://github.com/containers/image/pull/656
+	if in == nil {
+		return in
+	}
+
+	if strings.Contains(in.Error(), "authentication required") {
+		return fmt.Errorf("Could not authenticate to the registry: %w", in)
+	}
+	return in
+}
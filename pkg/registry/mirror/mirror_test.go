@@ -0,0 +1,59 @@
+package mirror
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+)
+
+func TestGenericRepository(t *testing.T) {
+	tests := []struct {
+		uri     string
+		want    string
+		wantErr bool
+	}{
+		{"harbor.example.com/falcon/falcon-sensor", "harbor.example.com/falcon/falcon-sensor", false},
+		{"registry.example.com:5000/falcon-sensor", "registry.example.com:5000/falcon-sensor", false},
+		{"falcon-sensor", "docker.io/library/falcon-sensor", false},
+		{"", "", true},
+		{"harbor.example.com/falcon/falcon-sensor:latest", "", true},
+		{"harbor.example.com/falcon/falcon-sensor@sha256:7e9d7e3bde4d6df7a3e5a6bcd1c6d8d5e9a4e1f57d3c8c5e0f7ef0b0b5a3c7d1", "", true},
+		{"Harbor.example.com/Falcon", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uri, func(t *testing.T) {
+			got, err := GenericRepository(tt.uri)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GenericRepository() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("GenericRepository() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCertDir(t *testing.T) {
+	dir, err := CertDir(context.Background(), nil, v1beta1.RegistryTLSSpec{}, "falcon-system", "test")
+	if err != nil || dir != "" {
+		t.Errorf("CertDir() = %s, %v, want no directory without CA certificates", dir, err)
+	}
+
+	dir, err = CertDir(context.Background(), nil, v1beta1.RegistryTLSSpec{CACertificate: "-----BEGIN CERTIFICATE-----"}, "falcon-system", filepath.Join("test", t.Name()))
+	if err != nil {
+		t.Fatalf("CertDir() error = %v", err)
+	}
+	defer os.RemoveAll(dir)
+
+	cert, err := os.ReadFile(filepath.Join(dir, "ca.crt"))
+	if err != nil {
+		t.Fatalf("CertDir() did not write ca.crt: %v", err)
+	}
+	if string(cert) != "-----BEGIN CERTIFICATE-----" {
+		t.Errorf("CertDir() wrote %q", cert)
+	}
+}
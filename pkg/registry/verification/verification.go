@@ -0,0 +1,155 @@
+package verification
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/containers/image/v5/docker"
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/containers/image/v5/image"
+	"github.com/containers/image/v5/manifest"
+	"github.com/containers/image/v5/signature"
+	"github.com/containers/image/v5/types"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+)
+
+// registriesDir holds a registries.d configuration that makes containers/image read (and copy) the sigstore
+// signatures cosign stores next to the image in the registry
+const registriesDir = "/tmp/falcon-operator/registries.d"
+
+const registriesConfig = `default-docker:
+  use-sigstore-attachments: true
+`
+
+var (
+	registriesDirOnce sync.Once
+	registriesDirErr  error
+)
+
+// Enabled returns true when the image verification settings require images to be verified
+func Enabled(spec *v1beta1.ImageVerificationSpec) bool {
+	return spec != nil && (len(spec.CosignPublicKeys) > 0 || spec.Policy != "")
+}
+
+// SystemContext returns a copy of the system context that reads sigstore signatures stored as registry attachments
+func SystemContext(sys *types.SystemContext) (*types.SystemContext, error) {
+	registriesDirOnce.Do(func() {
+		if err := os.MkdirAll(registriesDir, 0700); err != nil {
+			registriesDirErr = err
+			return
+		}
+		registriesDirErr = os.WriteFile(filepath.Join(registriesDir, "sigstore.yaml"), []byte(registriesConfig), 0600)
+	})
+	if registriesDirErr != nil {
+		return nil, fmt.Errorf("Cannot configure sigstore signature lookup: %v", registriesDirErr)
+	}
+
+	ctx := &types.SystemContext{}
+	if sys != nil {
+		*ctx = *sys
+	}
+	ctx.RegistriesDirPath = registriesDir
+	return ctx, nil
+}
+
+// Verify checks the image signatures against the image verification settings and returns the digest of the
+// verified manifest. signedRepository, when set, is the repository the image was originally signed in; it allows
+// a mirrored copy of the image to be verified against signatures made for the original repository.
+func Verify(ctx context.Context, spec *v1beta1.ImageVerificationSpec, ref types.ImageReference, sys *types.SystemContext, signedRepository string) (string, error) {
+	policies, err := policies(spec, signedRepository)
+	if err != nil {
+		return "", err
+	}
+
+	sys, err = SystemContext(sys)
+	if err != nil {
+		return "", err
+	}
+
+	src, err := ref.NewImageSource(ctx, sys)
+	if err != nil {
+		return "", fmt.Errorf("Cannot read image %s: %v", ref.StringWithinTransport(), err)
+	}
+	defer func() { _ = src.Close() }()
+
+	unparsed := image.UnparsedInstance(src, nil)
+	manifestBlob, _, err := unparsed.Manifest(ctx)
+	if err != nil {
+		return "", fmt.Errorf("Cannot read manifest of image %s: %v", ref.StringWithinTransport(), err)
+	}
+	manifestDigest, err := manifest.Digest(manifestBlob)
+	if err != nil {
+		return "", fmt.Errorf("Cannot compute manifest digest of image %s: %v", ref.StringWithinTransport(), err)
+	}
+
+	// Any of the policies accepting the image is sufficient: each cosign public key gets a policy of its own
+	failures := []string{}
+	for _, policy := range policies {
+		allowed, err := isAllowed(ctx, policy, unparsed)
+		if allowed {
+			return manifestDigest.String(), nil
+		}
+		if err != nil {
+			failures = append(failures, err.Error())
+		}
+	}
+
+	return "", fmt.Errorf("Image %s failed signature verification: %s", ref.StringWithinTransport(), strings.Join(failures, "; "))
+}
+
+// Pin returns a reference to the exact manifest digest of the image
+func Pin(ref types.ImageReference, manifestDigest string) (types.ImageReference, error) {
+	named := ref.DockerReference()
+	if named == nil {
+		return nil, fmt.Errorf("Cannot pin image %s to a digest", ref.StringWithinTransport())
+	}
+	return docker.ParseReference(fmt.Sprintf("//%s@%s", reference.TrimNamed(named).String(), manifestDigest))
+}
+
+func isAllowed(ctx context.Context, policy *signature.Policy, unparsed types.UnparsedImage) (bool, error) {
+	policyContext, err := signature.NewPolicyContext(policy)
+	if err != nil {
+		return false, fmt.Errorf("Error loading trust policy: %v", err)
+	}
+	defer func() { _ = policyContext.Destroy() }()
+
+	return policyContext.IsRunningImageAllowed(ctx, unparsed)
+}
+
+func policies(spec *v1beta1.ImageVerificationSpec, signedRepository string) ([]*signature.Policy, error) {
+	if !Enabled(spec) {
+		return nil, fmt.Errorf("Image verification is not configured")
+	}
+
+	if spec.Policy != "" {
+		policy, err := signature.NewPolicyFromBytes([]byte(spec.Policy))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid image verification policy: %v", err)
+		}
+		return []*signature.Policy{policy}, nil
+	}
+
+	identity := signature.NewPRMMatchRepoDigestOrExact()
+	if signedRepository != "" {
+		var err error
+		identity, err = signature.NewPRMExactRepository(signedRepository)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid signed repository %s: %v", signedRepository, err)
+		}
+	}
+
+	policies := []*signature.Policy{}
+	for i, key := range spec.CosignPublicKeys {
+		requirement, err := signature.NewPRSigstoreSignedKeyData([]byte(key), identity)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid cosign public key #%d: %v", i+1, err)
+		}
+		policies = append(policies, &signature.Policy{Default: signature.PolicyRequirements{requirement}})
+	}
+	return policies, nil
+}
@@ -0,0 +1,73 @@
+package verification
+
+import (
+	"testing"
+
+	"github.com/crowdstrike/falcon-operator/apis/falcon/v1beta1"
+)
+
+const testCosignKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEG9qW8w1KJ6/YEzJ8ZRBobFQwbgCv
+946dgwQ8eGlWNxErbNW+WapQTgwopfs351rTif9XDBI+rWwkyu1AJGXK4g==
+-----END PUBLIC KEY-----
+`
+
+func TestEnabled(t *testing.T) {
+	tests := []struct {
+		name string
+		spec *v1beta1.ImageVerificationSpec
+		want bool
+	}{
+		{"nil", nil, false},
+		{"empty", &v1beta1.ImageVerificationSpec{}, false},
+		{"cosign keys", &v1beta1.ImageVerificationSpec{CosignPublicKeys: []string{testCosignKey}}, true},
+		{"policy", &v1beta1.ImageVerificationSpec{Policy: `{"default": [{"type": "reject"}]}`}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Enabled(tt.spec); got != tt.want {
+				t.Errorf("Enabled() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPolicies(t *testing.T) {
+	tests := []struct {
+		name             string
+		spec             *v1beta1.ImageVerificationSpec
+		signedRepository string
+		want             int
+		wantErr          bool
+	}{
+		{"not configured", &v1beta1.ImageVerificationSpec{}, "", 0, true},
+		{"one policy per cosign key", &v1beta1.ImageVerificationSpec{CosignPublicKeys: []string{testCosignKey, testCosignKey}}, "", 2, false},
+		{"cosign key for mirrored image", &v1beta1.ImageVerificationSpec{CosignPublicKeys: []string{testCosignKey}}, "registry.crowdstrike.com/falcon-container/us-1/release/falcon-sensor", 1, false},
+		{"invalid signed repository", &v1beta1.ImageVerificationSpec{CosignPublicKeys: []string{testCosignKey}}, "Not A Repository", 0, true},
+		{"policy takes precedence", &v1beta1.ImageVerificationSpec{CosignPublicKeys: []string{testCosignKey}, Policy: `{"default": [{"type": "reject"}]}`}, "", 1, false},
+		{"invalid policy", &v1beta1.ImageVerificationSpec{Policy: `{"default": [{"type": "unknown"}]}`}, "", 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := policies(tt.spec, tt.signedRepository)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("policies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(got) != tt.want {
+				t.Errorf("policies() returned %d policies, want %d", len(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestSystemContext(t *testing.T) {
+	sys, err := SystemContext(nil)
+	if err != nil {
+		t.Fatalf("SystemContext() error = %v", err)
+	}
+	if sys.RegistriesDirPath != registriesDir {
+		t.Errorf("SystemContext() RegistriesDirPath = %s, want %s", sys.RegistriesDirPath, registriesDir)
+	}
+}
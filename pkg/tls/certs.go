@@ -2,39 +2,81 @@ package tls
 
 import (
 	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
 	"crypto/rand"
 	"crypto/rsa"
+	"crypto/sha1"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/asn1"
 	"encoding/pem"
+	"fmt"
 	"math/big"
 	"time"
 )
 
-// CertSetup will generate and return tls certs
-func CertSetup(days int) ([]byte, []byte, []byte, error) {
+// KeyAlgorithm selects the type and size of the keys generated by CertSetup
+type KeyAlgorithm string
+
+const (
+	RSA2048   KeyAlgorithm = "RSA-2048"
+	RSA3072   KeyAlgorithm = "RSA-3072"
+	RSA4096   KeyAlgorithm = "RSA-4096"
+	ECDSAP256 KeyAlgorithm = "ECDSA-P256"
+	ECDSAP384 KeyAlgorithm = "ECDSA-P384"
+
+	// DefaultKeyAlgorithm is used when no key algorithm is requested
+	DefaultKeyAlgorithm = RSA2048
+)
+
+// serialNumberLimit bounds random certificate serial numbers to 128 bits
+var serialNumberLimit = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// CertSetup will generate and return tls certs for the injector service in the given namespace
+func CertSetup(namespace string, days int, algorithm KeyAlgorithm) ([]byte, []byte, []byte, error) {
+	return ServiceCertSetup("falcon-sidecar-injector", namespace, days, algorithm)
+}
+
+// ServiceCertSetup will generate and return tls certs for the named service in the given namespace
+func ServiceCertSetup(service string, namespace string, days int, algorithm KeyAlgorithm) ([]byte, []byte, []byte, error) {
+	serviceName := fmt.Sprintf("%s.%s.svc", service, namespace)
+
+	// create the CA private and public key
+	caPrivKey, err := generateKey(algorithm)
+	if err != nil {
+		return []byte{}, []byte{}, []byte{}, err
+	}
+
+	caSerial, err := randomSerialNumber()
+	if err != nil {
+		return []byte{}, []byte{}, []byte{}, err
+	}
+
+	caKeyId, err := subjectKeyId(caPrivKey.Public())
+	if err != nil {
+		return []byte{}, []byte{}, []byte{}, err
+	}
+
 	// set up our CA certificate
 	ca := &x509.Certificate{
-		SerialNumber: new(big.Int).Lsh(big.NewInt(1), 128),
+		SerialNumber: caSerial,
 		Subject: pkix.Name{
-			CommonName: "falcon-system ca",
+			CommonName: fmt.Sprintf("%s ca", namespace),
 		},
 		NotBefore:             time.Now(),
 		NotAfter:              time.Now().AddDate(0, 0, days),
 		IsCA:                  true,
 		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
-		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
-	}
-
-	// create our private and public key
-	caPrivKey, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		return []byte{}, []byte{}, []byte{}, err
+		SubjectKeyId:          caKeyId,
+		AuthorityKeyId:        caKeyId,
 	}
 
 	// create the CA
-	caBytes, err := x509.CreateCertificate(rand.Reader, ca, ca, &caPrivKey.PublicKey, caPrivKey)
+	caBytes, err := x509.CreateCertificate(rand.Reader, ca, ca, caPrivKey.Public(), caPrivKey)
 	if err != nil {
 		return []byte{}, []byte{}, []byte{}, err
 	}
@@ -48,34 +90,44 @@ func CertSetup(days int) ([]byte, []byte, []byte, error) {
 		return []byte{}, []byte{}, []byte{}, err
 	}
 
-	caPrivKeyPEM := new(bytes.Buffer)
-	if err = pem.Encode(caPrivKeyPEM, &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(caPrivKey),
-	}); err != nil {
+	// create the server private and public key
+	certPrivKey, err := generateKey(algorithm)
+	if err != nil {
+		return []byte{}, []byte{}, []byte{}, err
+	}
+
+	certSerial, err := randomSerialNumber()
+	if err != nil {
 		return []byte{}, []byte{}, []byte{}, err
 	}
 
+	certKeyId, err := subjectKeyId(certPrivKey.Public())
+	if err != nil {
+		return []byte{}, []byte{}, []byte{}, err
+	}
+
+	// RSA keys are also used for key exchange; ECDSA keys only sign
+	keyUsage := x509.KeyUsageDigitalSignature
+	if _, ok := certPrivKey.(*rsa.PrivateKey); ok {
+		keyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	// set up our server certificate
 	cert := &x509.Certificate{
-		SerialNumber: new(big.Int).Lsh(big.NewInt(1), 128),
+		SerialNumber: certSerial,
 		Subject: pkix.Name{
-			CommonName: "falcon-sidecar-injector.falcon-system.svc",
+			CommonName: serviceName,
 		},
 		NotBefore:    time.Now(),
 		NotAfter:     time.Now().AddDate(0, 0, days),
-		SubjectKeyId: []byte("234567"),
+		SubjectKeyId: certKeyId,
 		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
-		KeyUsage:     x509.KeyUsageDigitalSignature,
-		DNSNames:     []string{"falcon-sidecar-injector.falcon-system.svc", "falcon-sidecar-injector.falcon-system.svc.cluster.local"},
-	}
-
-	certPrivKey, err := rsa.GenerateKey(rand.Reader, 2048)
-	if err != nil {
-		return []byte{}, []byte{}, []byte{}, err
+		KeyUsage:     keyUsage,
+		DNSNames:     []string{serviceName, serviceName + ".cluster.local"},
 	}
 
-	certBytes, err := x509.CreateCertificate(rand.Reader, cert, ca, &certPrivKey.PublicKey, caPrivKey)
+	// the authority key identifier is taken from the CA subject key identifier
+	certBytes, err := x509.CreateCertificate(rand.Reader, cert, ca, certPrivKey.Public(), caPrivKey)
 	if err != nil {
 		return []byte{}, []byte{}, []byte{}, err
 	}
@@ -88,19 +140,123 @@ func CertSetup(days int) ([]byte, []byte, []byte, error) {
 		return []byte{}, []byte{}, []byte{}, err
 	}
 
-	certPrivKeyPEM := new(bytes.Buffer)
-	if err = pem.Encode(certPrivKeyPEM, &pem.Block{
-		Type:  "RSA PRIVATE KEY",
-		Bytes: x509.MarshalPKCS1PrivateKey(certPrivKey),
-	}); err != nil {
+	certPrivKeyBlock, err := privateKeyPEMBlock(certPrivKey)
+	if err != nil {
 		return []byte{}, []byte{}, []byte{}, err
 	}
 
-	certpool := x509.NewCertPool()
-	certpool.AppendCertsFromPEM(caPEM.Bytes())
+	certPrivKeyPEM := new(bytes.Buffer)
+	if err = pem.Encode(certPrivKeyPEM, certPrivKeyBlock); err != nil {
+		return []byte{}, []byte{}, []byte{}, err
+	}
 
 	return certPEM.Bytes(),
 		certPrivKeyPEM.Bytes(),
 		caPEM.Bytes(),
 		nil
 }
+
+// KeyAlgorithmOf returns the key algorithm of the certificate public key, or an empty string when it is not supported
+func KeyAlgorithmOf(cert *x509.Certificate) KeyAlgorithm {
+	switch pub := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		switch pub.N.BitLen() {
+		case 2048:
+			return RSA2048
+		case 3072:
+			return RSA3072
+		case 4096:
+			return RSA4096
+		}
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			return ECDSAP256
+		case elliptic.P384():
+			return ECDSAP384
+		}
+	}
+	return ""
+}
+
+// ParseCert parses the first certificate in the PEM encoded bundle
+func ParseCert(pemBytes []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse certificate: %v", err)
+	}
+
+	return cert, nil
+}
+
+// CertNotAfter returns the expiry time of the first certificate in the PEM encoded bundle
+func CertNotAfter(pemBytes []byte) (time.Time, error) {
+	cert, err := ParseCert(pemBytes)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return cert.NotAfter, nil
+}
+
+func generateKey(algorithm KeyAlgorithm) (crypto.Signer, error) {
+	switch algorithm {
+	case "", RSA2048:
+		return rsa.GenerateKey(rand.Reader, 2048)
+	case RSA3072:
+		return rsa.GenerateKey(rand.Reader, 3072)
+	case RSA4096:
+		return rsa.GenerateKey(rand.Reader, 4096)
+	case ECDSAP256:
+		return ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	case ECDSAP384:
+		return ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	}
+	return nil, fmt.Errorf("unsupported key algorithm: %s", algorithm)
+}
+
+func privateKeyPEMBlock(key crypto.Signer) (*pem.Block, error) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return &pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(k)}, nil
+	case *ecdsa.PrivateKey:
+		b, err := x509.MarshalECPrivateKey(k)
+		if err != nil {
+			return nil, err
+		}
+		return &pem.Block{Type: "EC PRIVATE KEY", Bytes: b}, nil
+	}
+	return nil, fmt.Errorf("unsupported private key type: %T", key)
+}
+
+func randomSerialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, serialNumberLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate serial number: %v", err)
+	}
+	return serial, nil
+}
+
+// subjectKeyId computes the key identifier as the SHA-1 hash of the public key bits (RFC 5280, section 4.2.1.2)
+func subjectKeyId(pub crypto.PublicKey) ([]byte, error) {
+	spki, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	var info struct {
+		Algorithm        pkix.AlgorithmIdentifier
+		SubjectPublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(spki, &info); err != nil {
+		return nil, err
+	}
+
+	sum := sha1.Sum(info.SubjectPublicKey.Bytes)
+	return sum[:], nil
+}
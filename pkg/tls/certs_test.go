@@ -0,0 +1,142 @@
+package tls
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"testing"
+)
+
+func TestCertSetup(t *testing.T) {
+	tests := []struct {
+		algorithm KeyAlgorithm
+		want      KeyAlgorithm
+	}{
+		{"", RSA2048},
+		{RSA2048, RSA2048},
+		{RSA3072, RSA3072},
+		{RSA4096, RSA4096},
+		{ECDSAP256, ECDSAP256},
+		{ECDSAP384, ECDSAP384},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.want), func(t *testing.T) {
+			certPEM, keyPEM, caPEM, err := CertSetup("falcon-system", 30, tt.algorithm)
+			if err != nil {
+				t.Fatalf("CertSetup() error = %v", err)
+			}
+
+			ca, err := ParseCert(caPEM)
+			if err != nil {
+				t.Fatalf("ParseCert() CA error = %v", err)
+			}
+			cert, err := ParseCert(certPEM)
+			if err != nil {
+				t.Fatalf("ParseCert() certificate error = %v", err)
+			}
+
+			roots := x509.NewCertPool()
+			roots.AddCert(ca)
+			for _, dnsName := range []string{"falcon-sidecar-injector.falcon-system.svc", "falcon-sidecar-injector.falcon-system.svc.cluster.local"} {
+				if _, err := cert.Verify(x509.VerifyOptions{
+					DNSName:   dnsName,
+					Roots:     roots,
+					KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+				}); err != nil {
+					t.Errorf("Verify() for %s error = %v", dnsName, err)
+				}
+			}
+
+			if got := KeyAlgorithmOf(ca); got != tt.want {
+				t.Errorf("KeyAlgorithmOf() CA = %v, want %v", got, tt.want)
+			}
+			if got := KeyAlgorithmOf(cert); got != tt.want {
+				t.Errorf("KeyAlgorithmOf() certificate = %v, want %v", got, tt.want)
+			}
+
+			if !ca.IsCA {
+				t.Errorf("CA certificate is not a CA")
+			}
+			if len(ca.SubjectKeyId) == 0 || len(cert.SubjectKeyId) == 0 {
+				t.Errorf("SubjectKeyId is missing")
+			}
+			if !bytes.Equal(cert.AuthorityKeyId, ca.SubjectKeyId) {
+				t.Errorf("AuthorityKeyId = %x, want CA SubjectKeyId %x", cert.AuthorityKeyId, ca.SubjectKeyId)
+			}
+			if bytes.Equal(cert.SubjectKeyId, ca.SubjectKeyId) {
+				t.Errorf("certificate and CA share SubjectKeyId %x", cert.SubjectKeyId)
+			}
+			if cert.SerialNumber.Cmp(ca.SerialNumber) == 0 {
+				t.Errorf("certificate and CA share serial number %v", cert.SerialNumber)
+			}
+
+			// the private key must match the serving certificate
+			pair, err := tls.X509KeyPair(certPEM, keyPEM)
+			if err != nil {
+				t.Fatalf("X509KeyPair() error = %v", err)
+			}
+			switch pair.PrivateKey.(type) {
+			case *rsa.PrivateKey, *ecdsa.PrivateKey:
+			default:
+				t.Errorf("unexpected private key type %T", pair.PrivateKey)
+			}
+		})
+	}
+}
+
+func TestCertSetupRandomSerial(t *testing.T) {
+	first, _, _, err := CertSetup("falcon-system", 30, ECDSAP256)
+	if err != nil {
+		t.Fatalf("CertSetup() error = %v", err)
+	}
+	second, _, _, err := CertSetup("falcon-system", 30, ECDSAP256)
+	if err != nil {
+		t.Fatalf("CertSetup() error = %v", err)
+	}
+
+	firstCert, err := ParseCert(first)
+	if err != nil {
+		t.Fatalf("ParseCert() error = %v", err)
+	}
+	secondCert, err := ParseCert(second)
+	if err != nil {
+		t.Fatalf("ParseCert() error = %v", err)
+	}
+
+	if firstCert.SerialNumber.Cmp(secondCert.SerialNumber) == 0 {
+		t.Errorf("CertSetup() reused serial number %v", firstCert.SerialNumber)
+	}
+}
+
+func TestCertSetupUnsupportedAlgorithm(t *testing.T) {
+	if _, _, _, err := CertSetup("falcon-system", 30, "DSA-1024"); err == nil {
+		t.Errorf("CertSetup() expected an error for an unsupported key algorithm")
+	}
+}
+
+func TestCertNotAfter(t *testing.T) {
+	_, _, caPEM, err := CertSetup("falcon-system", 30, ECDSAP256)
+	if err != nil {
+		t.Fatalf("CertSetup() error = %v", err)
+	}
+
+	ca, err := ParseCert(caPEM)
+	if err != nil {
+		t.Fatalf("ParseCert() error = %v", err)
+	}
+
+	got, err := CertNotAfter(caPEM)
+	if err != nil {
+		t.Fatalf("CertNotAfter() error = %v", err)
+	}
+	if !got.Equal(ca.NotAfter) {
+		t.Errorf("CertNotAfter() = %v, want %v", got, ca.NotAfter)
+	}
+
+	if _, err := CertNotAfter([]byte("not a certificate")); err == nil {
+		t.Errorf("CertNotAfter() expected an error for invalid PEM")
+	}
+}
@@ -0,0 +1,205 @@
+package webhook_cert
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/crowdstrike/falcon-operator/pkg/tls"
+	"github.com/go-logr/logr"
+	arv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// certValidity is the lifetime of the serving certificate in days
+	certValidity = 3650
+	// renewBefore is how long before its expiry the serving certificate is replaced when the operator starts
+	renewBefore = 30 * 24 * time.Hour
+)
+
+// Options locates the Secret holding the serving certificate of the operator webhooks and the Service they are called through
+type Options struct {
+	// Namespace of the Secret and the Service
+	Namespace string
+	// SecretName is the Secret the serving certificate is kept in
+	SecretName string
+	// ServiceName is the Service the CRD conversion webhooks and the admission webhooks call
+	ServiceName string
+	// CertDir is the directory the webhook server reads tls.crt and tls.key from
+	CertDir string
+}
+
+// Provision makes sure the Secret holds a serving certificate for the webhook Service, writes it to the certificate directory of
+// the webhook server and injects its CA into the CRD conversion webhooks and the admission webhooks that call the Service. It is
+// used where neither OLM nor cert-manager provide the certificate. The Secret is shared by all replicas of the operator.
+func Provision(ctx context.Context, cli client.Client, log logr.Logger, opts Options) error {
+	secret, err := servingSecret(ctx, cli, log, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(opts.CertDir, 0700); err != nil {
+		return err
+	}
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if err := os.WriteFile(filepath.Join(opts.CertDir, key), secret.Data[key], 0600); err != nil {
+			return err
+		}
+	}
+
+	return injectCA(ctx, cli, log, opts, secret.Data["ca.crt"])
+}
+
+// servingSecret returns the Secret holding the serving certificate, creating it or replacing the certificate when it is unusable
+func servingSecret(ctx context.Context, cli client.Client, log logr.Logger, opts Options) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	err := cli.Get(ctx, types.NamespacedName{Name: opts.SecretName, Namespace: opts.Namespace}, secret)
+	if err != nil && !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("unable to query webhook serving certificate Secret %s: %v", opts.SecretName, err)
+	}
+	exists := err == nil
+	if exists {
+		if reason := unusable(secret, opts); reason == "" {
+			return secret, nil
+		} else {
+			log.Info("Regenerating webhook serving certificate", "reason", reason)
+		}
+	}
+
+	c, k, b, err := tls.ServiceCertSetup(opts.ServiceName, opts.Namespace, certValidity, tls.DefaultKeyAlgorithm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate webhook serving certificate: %v", err)
+	}
+	data := map[string][]byte{
+		corev1.TLSCertKey:       c,
+		corev1.TLSPrivateKeyKey: k,
+		"ca.crt":                b,
+	}
+
+	if exists {
+		secret.Data = data
+		if err := cli.Update(ctx, secret); err != nil {
+			return nil, fmt.Errorf("unable to update webhook serving certificate Secret %s: %v", opts.SecretName, err)
+		}
+		return secret, nil
+	}
+
+	secret = &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: opts.SecretName, Namespace: opts.Namespace},
+		Type:       corev1.SecretTypeTLS,
+		Data:       data,
+	}
+	err = cli.Create(ctx, secret)
+	if errors.IsAlreadyExists(err) {
+		// Another replica of the operator created the certificate first
+		err = cli.Get(ctx, types.NamespacedName{Name: opts.SecretName, Namespace: opts.Namespace}, secret)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create webhook serving certificate Secret %s: %v", opts.SecretName, err)
+	}
+	log.Info("Created webhook serving certificate", "secret", opts.SecretName)
+	return secret, nil
+}
+
+// unusable returns why the certificate in the Secret cannot serve the webhook Service, or an empty string when it can
+func unusable(secret *corev1.Secret, opts Options) string {
+	if len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 || len(secret.Data["ca.crt"]) == 0 {
+		return "incomplete certificate"
+	}
+	cert, err := tls.ParseCert(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return err.Error()
+	}
+	if err := cert.VerifyHostname(fmt.Sprintf("%s.%s.svc", opts.ServiceName, opts.Namespace)); err != nil {
+		return err.Error()
+	}
+	if time.Now().Add(renewBefore).After(cert.NotAfter) {
+		return fmt.Sprintf("expires at %s", cert.NotAfter)
+	}
+	return ""
+}
+
+// injectCA sets the CA bundle of the CRD conversion webhooks and the admission webhooks that call the webhook Service
+func injectCA(ctx context.Context, cli client.Client, log logr.Logger, opts Options, caBundle []byte) error {
+	calls := func(service *arv1.ServiceReference) bool {
+		return service != nil && service.Name == opts.ServiceName && service.Namespace == opts.Namespace
+	}
+
+	crds := &apiextensionsv1.CustomResourceDefinitionList{}
+	if err := cli.List(ctx, crds); err != nil {
+		return fmt.Errorf("unable to list CustomResourceDefinitions: %v", err)
+	}
+	for i := range crds.Items {
+		crd := &crds.Items[i]
+		if crd.Spec.Conversion == nil || crd.Spec.Conversion.Webhook == nil || crd.Spec.Conversion.Webhook.ClientConfig == nil {
+			continue
+		}
+		clientConfig := crd.Spec.Conversion.Webhook.ClientConfig
+		service := clientConfig.Service
+		if service == nil || service.Name != opts.ServiceName || service.Namespace != opts.Namespace || bytes.Equal(clientConfig.CABundle, caBundle) {
+			continue
+		}
+		clientConfig.CABundle = caBundle
+		if err := cli.Update(ctx, crd); err != nil {
+			return fmt.Errorf("unable to inject webhook CA into CustomResourceDefinition %s: %v", crd.Name, err)
+		}
+		log.Info("Injected webhook CA", "CustomResourceDefinition", crd.Name)
+	}
+
+	validating := &arv1.ValidatingWebhookConfigurationList{}
+	if err := cli.List(ctx, validating); err != nil {
+		return fmt.Errorf("unable to list ValidatingWebhookConfigurations: %v", err)
+	}
+	for i := range validating.Items {
+		config := &validating.Items[i]
+		updated := false
+		for j := range config.Webhooks {
+			clientConfig := &config.Webhooks[j].ClientConfig
+			if calls(clientConfig.Service) && !bytes.Equal(clientConfig.CABundle, caBundle) {
+				clientConfig.CABundle = caBundle
+				updated = true
+			}
+		}
+		if !updated {
+			continue
+		}
+		if err := cli.Update(ctx, config); err != nil {
+			return fmt.Errorf("unable to inject webhook CA into ValidatingWebhookConfiguration %s: %v", config.Name, err)
+		}
+		log.Info("Injected webhook CA", "ValidatingWebhookConfiguration", config.Name)
+	}
+
+	mutating := &arv1.MutatingWebhookConfigurationList{}
+	if err := cli.List(ctx, mutating); err != nil {
+		return fmt.Errorf("unable to list MutatingWebhookConfigurations: %v", err)
+	}
+	for i := range mutating.Items {
+		config := &mutating.Items[i]
+		updated := false
+		for j := range config.Webhooks {
+			clientConfig := &config.Webhooks[j].ClientConfig
+			if calls(clientConfig.Service) && !bytes.Equal(clientConfig.CABundle, caBundle) {
+				clientConfig.CABundle = caBundle
+				updated = true
+			}
+		}
+		if !updated {
+			continue
+		}
+		if err := cli.Update(ctx, config); err != nil {
+			return fmt.Errorf("unable to inject webhook CA into MutatingWebhookConfiguration %s: %v", config.Name, err)
+		}
+		log.Info("Injected webhook CA", "MutatingWebhookConfiguration", config.Name)
+	}
+
+	return nil
+}
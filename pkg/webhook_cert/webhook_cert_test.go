@@ -0,0 +1,139 @@
+package webhook_cert
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	arv1 "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestProvision(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := apiextensionsv1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	opts := Options{
+		Namespace:   "falcon-operator",
+		SecretName:  "webhook-server-cert",
+		ServiceName: "falcon-operator-webhook-service",
+		CertDir:     t.TempDir(),
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "falconnodesensors.falcon.crowdstrike.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Conversion: &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+				Webhook: &apiextensionsv1.WebhookConversion{
+					ClientConfig: &apiextensionsv1.WebhookClientConfig{
+						Service: &apiextensionsv1.ServiceReference{Name: opts.ServiceName, Namespace: opts.Namespace},
+					},
+				},
+			},
+		},
+	}
+	otherCRD := &apiextensionsv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{Name: "others.example.com"},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Conversion: &apiextensionsv1.CustomResourceConversion{
+				Strategy: apiextensionsv1.WebhookConverter,
+				Webhook: &apiextensionsv1.WebhookConversion{
+					ClientConfig: &apiextensionsv1.WebhookClientConfig{
+						Service: &apiextensionsv1.ServiceReference{Name: opts.ServiceName, Namespace: "other"},
+					},
+				},
+			},
+		},
+	}
+	validating := &arv1.ValidatingWebhookConfiguration{
+		ObjectMeta: metav1.ObjectMeta{Name: "falcon-operator-validating-webhook-configuration"},
+		Webhooks: []arv1.ValidatingWebhook{{
+			Name: "vfalconnodesensor.kb.io",
+			ClientConfig: arv1.WebhookClientConfig{
+				Service: &arv1.ServiceReference{Name: opts.ServiceName, Namespace: opts.Namespace},
+			},
+		}},
+	}
+	cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crd, otherCRD, validating).Build()
+
+	ctx := context.Background()
+	if err := Provision(ctx, cli, logr.Discard(), opts); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+
+	secret := &corev1.Secret{}
+	if err := cli.Get(ctx, types.NamespacedName{Name: opts.SecretName, Namespace: opts.Namespace}, secret); err != nil {
+		t.Fatalf("Get() Secret error = %v", err)
+	}
+	if reason := unusable(secret, opts); reason != "" {
+		t.Errorf("provisioned certificate is unusable: %s", reason)
+	}
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		got, err := os.ReadFile(filepath.Join(opts.CertDir, key))
+		if err != nil {
+			t.Fatalf("ReadFile() %s error = %v", key, err)
+		}
+		if !bytes.Equal(got, secret.Data[key]) {
+			t.Errorf("%s in the certificate directory does not match the Secret", key)
+		}
+	}
+
+	caBundle := secret.Data["ca.crt"]
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(crd), crd); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(crd.Spec.Conversion.Webhook.ClientConfig.CABundle, caBundle) {
+		t.Errorf("CA was not injected into CustomResourceDefinition %s", crd.Name)
+	}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(otherCRD), otherCRD); err != nil {
+		t.Fatal(err)
+	}
+	if len(otherCRD.Spec.Conversion.Webhook.ClientConfig.CABundle) != 0 {
+		t.Errorf("CA was injected into CustomResourceDefinition %s of another Service", otherCRD.Name)
+	}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(validating), validating); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(validating.Webhooks[0].ClientConfig.CABundle, caBundle) {
+		t.Errorf("CA was not injected into ValidatingWebhookConfiguration %s", validating.Name)
+	}
+
+	// A usable certificate is kept across restarts of the operator
+	if err := Provision(ctx, cli, logr.Discard(), opts); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	kept := &corev1.Secret{}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(secret), kept); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(kept.Data[corev1.TLSCertKey], secret.Data[corev1.TLSCertKey]) {
+		t.Error("Provision() replaced a usable certificate")
+	}
+
+	// A certificate for another Service is replaced
+	opts.ServiceName = "renamed-webhook-service"
+	if err := Provision(ctx, cli, logr.Discard(), opts); err != nil {
+		t.Fatalf("Provision() error = %v", err)
+	}
+	if err := cli.Get(ctx, client.ObjectKeyFromObject(secret), kept); err != nil {
+		t.Fatal(err)
+	}
+	if reason := unusable(kept, opts); reason != "" {
+		t.Errorf("replaced certificate is unusable: %s", reason)
+	}
+}